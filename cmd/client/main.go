@@ -1,64 +1,148 @@
 package main
 
 import (
-    "context"
-    "log"
-    "os"
-    "time"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
 
-    "github.com/caring/call-handling/pb"
-    "google.golang.org/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/caring/call-handling/pb"
 )
 
 const (
-    defaultAddress = "localhost:"+envMust("PORT")
-    defaultData    = "00"
+	defaultData   = "00"
+	tenantHeader  = "x-tenant-id"
+	timeLayout    = "2006-01-02T15:04:05Z07:00"
+	usageCommands = "usage: client [ping|export] [flags]"
 )
 
 func main() {
-    data := defaultData
-    address := defaultAddress
-    if len(os.Args) > 2 {
-        address = os.Args[1]
-        data = os.Args[2]
-    }
-
-    conn, err := grpc.Dial(address, grpc.WithInsecure())
-    if err != nil {
-        log.Fatalf("did not connect: %v", err)
-    }
-    defer conn.Close()
-    c := pb.NewCallHandlingServiceClient(conn)
-
-    index := 0
-    for {
-        trip_time := time.Now()
-        ctx, cancel := context.WithTimeout(context.Background(), time.Second)
-        defer cancel()
-        r, err := c.Ping(ctx, &pb.PingRequest{Data: data})
-        if err != nil {
-            log.Fatalf("could not connect to: %v", err)
-        }
-
-        log.Printf("%d characters roundtrip to (%s): seq=%d time=%s", len(data), address, index, time.Since(trip_time))
-        log.Print(r.Data)
-        time.Sleep(1 * time.Second)
-        index++
-    }
+	if len(os.Args) < 2 {
+		log.Fatalln(usageCommands)
+	}
+
+	switch os.Args[1] {
+	case "ping":
+		ping(os.Args[2:])
+	case "export":
+		export(os.Args[2:])
+	default:
+		log.Fatalln(usageCommands)
+	}
 }
 
-// fetches and returns the given env variable, fatals and
-// captures an exception if the variable is an empty string
-func envMust(varName string) string {
-	value := os.Getenv(varName)
-	if value == "" {
-		e := errors.New("environment variable missing - " + varName)
-		sentry.CaptureException(e)
-		if l != nil {
-			l.Fatal(e.Error())
-		} else {
-			log.Fatalln(e.Error())
+// ping sends a ping every second and logs the round trip time
+func ping(args []string) {
+	fs := flag.NewFlagSet("ping", flag.ExitOnError)
+	address := fs.String("address", "localhost:"+os.Getenv("PORT"), "server address")
+	data := fs.String("data", defaultData, "data to send")
+	fs.Parse(args)
+
+	conn := dial(*address)
+	defer conn.Close()
+	c := pb.NewCallhandlingClient(conn)
+
+	for index := 0; ; index++ {
+		tripTime := time.Now()
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		r, err := c.Ping(ctx, &pb.PingRequest{Data: *data})
+		cancel()
+		if err != nil {
+			log.Fatalf("could not connect to: %v", err)
+		}
+
+		log.Printf("%d characters roundtrip to (%s): seq=%d time=%s", len(*data), *address, index, time.Since(tripTime))
+		log.Print(r.Data)
+		time.Sleep(1 * time.Second)
+	}
+}
+
+// export streams a call export for a tenant and time range to a file or stdout
+func export(args []string) {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	address := fs.String("address", "localhost:"+os.Getenv("PORT"), "server address")
+	tenant := fs.String("tenant", "", "tenant to export calls for")
+	from := fs.String("from", "", "start of the time range, RFC 3339")
+	to := fs.String("to", "", "end of the time range, RFC 3339")
+	format := fs.String("format", "csv", "csv, ndjson or parquet")
+	status := fs.String("status", "", "only export calls with this status")
+	dnis := fs.String("dnis", "", "only export calls to this number")
+	outcome := fs.String("outcome", "", "only export calls with this outcome")
+	out := fs.String("out", "", "file to write, defaults to stdout")
+	fs.Parse(args)
+
+	if *tenant == "" {
+		log.Fatalln("-tenant is required")
+	}
+	fromTime, err := time.Parse(timeLayout, *from)
+	if err != nil {
+		log.Fatalf("invalid -from: %v", err)
+	}
+	toTime, err := time.Parse(timeLayout, *to)
+	if err != nil {
+		log.Fatalf("invalid -to: %v", err)
+	}
+	f, ok := pb.ExportFormat_value[strings.ToUpper(*format)]
+	if !ok {
+		log.Fatalf("invalid -format: %s", *format)
+	}
+
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		file, err := os.Create(*out)
+		if err != nil {
+			log.Fatalf("could not create output: %v", err)
 		}
+		defer file.Close()
+		w = file
+	}
+
+	conn := dial(*address)
+	defer conn.Close()
+	c := pb.NewCallhandlingClient(conn)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), tenantHeader, *tenant)
+	stream, err := c.ExportCalls(ctx, &pb.ExportCallsRequest{
+		From:    fromTime.Unix(),
+		To:      toTime.Unix(),
+		Format:  pb.ExportFormat(f),
+		Status:  *status,
+		DNIS:    *dnis,
+		Outcome: *outcome,
+	})
+	if err != nil {
+		log.Fatalf("could not start export: %v", err)
 	}
-	return value
-}
\ No newline at end of file
+
+	var n int
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatalf("export failed: %v", err)
+		}
+		if _, err = w.Write(chunk.GetData()); err != nil {
+			log.Fatalf("could not write output: %v", err)
+		}
+		n += len(chunk.GetData())
+	}
+
+	fmt.Fprintf(os.Stderr, "exported %d bytes\n", n)
+}
+
+func dial(address string) *grpc.ClientConn {
+	conn, err := grpc.Dial(address, grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("did not connect: %v", err)
+	}
+	return conn
+}
@@ -2,17 +2,75 @@ package main
 
 // This file contains helpers to initialize application code that is specific to this service
 import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/caring/call-handling/internal/auth"
 	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/internal/fieldcrypt"
+	"github.com/caring/call-handling/internal/handlers"
+	"github.com/caring/call-handling/internal/redact"
+	"github.com/caring/call-handling/pb"
 	"github.com/caring/go-packages/pkg/logging"
 	"github.com/getsentry/sentry-go"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 
+// install the PII redaction policy from env
+func initRedaction(logger *logging.Logger) {
+	logger.Debug("Initializing PII redaction")
+	redact.SetPolicy(redact.ParsePolicy(envMust("PII_SENSITIVE_FIELDS")))
+	logger.Debug("Done")
+}
+
+// initialize the PII encrypter with the key provider selected by env
+func initEncrypter(logger *logging.Logger) *fieldcrypt.Encrypter {
+	logger.Debug("Initializing PII encryption")
+	var (
+		provider fieldcrypt.KeyProvider
+		err      error
+	)
+	switch p := envMust("PII_KEY_PROVIDER"); p {
+	case "kms":
+		provider, err = fieldcrypt.NewKMSProvider(envMust("PII_KMS_KEY_ID"))
+	case "local":
+		provider, err = fieldcrypt.LoadKeyring(envMust("PII_KEYRING_FILE"))
+	default:
+		logger.Fatal("Unknown PII_KEY_PROVIDER: " + p)
+	}
+	if err != nil {
+		sentry.CaptureException(err)
+		logger.Fatal("Failed to initialize PII key provider:" + err.Error())
+	}
+
+	indexKey, err := base64.StdEncoding.DecodeString(envMust("PII_INDEX_KEY"))
+	if err != nil {
+		logger.Fatal("Error decoding PII_INDEX_KEY variable")
+	}
+
+	enc, err := fieldcrypt.NewEncrypter(provider, indexKey)
+	if err != nil {
+		sentry.CaptureException(err)
+		logger.Fatal("Failed to initialize PII encryption:" + err.Error())
+	}
+	logger.Debug("Done")
+	return enc
+}
+
 // initialize the store service
-func initStore(logger *logging.Logger, connectionString string) *db.Store {
+func initStore(logger *logging.Logger, connectionString string, enc *fieldcrypt.Encrypter) *db.Store {
 	logger.Debug("Initializing Store")
 	// establish a store and connection to the db
-	store, err := db.NewStore(connectionString)
+	store, err := db.NewStore(connectionString, enc)
 	if err != nil {
 		sentry.CaptureException(err)
 		logger.Fatal("Failed to initialize store:" + err.Error())
@@ -21,3 +79,181 @@ func initStore(logger *logging.Logger, connectionString string) *db.Store {
 	return store
 }
 
+
+// initialize the registry of event types RecordEvent accepts
+func initEventTypes(logger *logging.Logger, groups ...[]*handlers.EventType) *handlers.EventRegistry {
+	logger.Debug("Initializing event types")
+	registry, err := handlers.NewEventRegistry()
+	if err != nil {
+		logger.Fatal("Failed to initialize event types:" + err.Error())
+	}
+	for _, types := range groups {
+		for _, t := range types {
+			if err = registry.Register(t); err != nil {
+				logger.Fatal("Failed to register event type:" + err.Error())
+			}
+		}
+	}
+	logger.Debug("Done", logging.Strings("event_types", registry.Names()))
+	return registry
+}
+
+// reportEffectError logs an event side effect that failed. The event itself was recorded,
+// so the failure is not returned to the client.
+func reportEffectError(logger *logging.Logger) handlers.EffectErrorHandler {
+	return func(ctx context.Context, e *db.Event, err error) {
+		sentry.CaptureException(err)
+		logger.Error("Error running event effect:"+redact.Text(err.Error()),
+			logging.Int64("call_id", e.CallID),
+			logging.String("event_type", e.Type),
+		)
+	}
+}
+
+// reportCDR delivers call detail records to the reporting stream
+func reportCDR(logger *logging.Logger) func(*db.CDR) {
+	return func(cdr *db.CDR) {
+		logger.Report("Call detail record",
+			logging.Int64("call_id", cdr.CallID),
+			logging.String("tenant_id", cdr.TenantID),
+			logging.Int64("conversation_id", cdr.ConversationID),
+			logging.String("DNIS_e164", cdr.DNISE164),
+			logging.String("ANI_hash", cdr.ANIHash),
+			logging.String("DNIS_hash", cdr.DNISHash),
+			logging.Int64s("parties", cdr.Parties),
+			logging.Int64("started_at", cdr.StartedAt),
+			logging.Int64("enqueued_at", cdr.EnqueuedAt),
+			logging.Int64("ringing_at", cdr.RingingAt),
+			logging.Int64("connected_at", cdr.ConnectedAt),
+			logging.Int64("disconnected_at", cdr.DisconnectedAt),
+			logging.Int64("dispositioned_at", cdr.DispositionedAt),
+			logging.Int64("queue_seconds", cdr.QueueSeconds),
+			logging.Int64("ring_seconds", cdr.RingSeconds),
+			logging.Int64("talk_seconds", cdr.TalkSeconds),
+			logging.Int64("duration_seconds", cdr.DurationSeconds),
+			logging.String("queue", cdr.Queue),
+			logging.String("disposition", cdr.Disposition),
+			logging.String("disconnect_cause", cdr.DisconnectCause),
+		)
+	}
+}
+
+// create the REST/JSON gateway, which proxies to the gRPC server on the same port so that
+// requests pass through the same interceptors as native gRPC calls. The gateway forwards the
+// Authorization header, whose bearer token the tenant interceptor verifies.
+func createGateway(logger *logging.Logger) http.Handler {
+	logger.Debug("Initializing REST gateway")
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+			if strings.EqualFold(key, handlers.TenantHeader) {
+				return handlers.TenantHeader, true
+			}
+			return runtime.DefaultHeaderMatcher(key)
+		}),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{OrigName: true, EmitDefaults: true}),
+	)
+
+	err := pb.RegisterCallhandlingHandlerFromEndpoint(context.Background(), mux, "localhost:"+envMust("PORT"),
+		[]grpc.DialOption{grpc.WithInsecure()})
+	if err != nil {
+		sentry.CaptureException(err)
+		logger.Fatal("Failed to initialize REST gateway:" + err.Error())
+	}
+	logger.Debug("Done")
+	return mux
+}
+
+// init the verifier of the bearer tokens that carry a caller's role
+func initTokenVerifier(logger *logging.Logger) *auth.Verifier {
+	logger.Debug("Initializing token verification")
+	key, err := base64.StdEncoding.DecodeString(envMust("AUTH_TOKEN_KEY"))
+	if err != nil {
+		logger.Fatal("Error decoding AUTH_TOKEN_KEY variable")
+	}
+
+	tokens, err := auth.NewVerifier(key)
+	if err != nil {
+		sentry.CaptureException(err)
+		logger.Fatal("Failed to initialize token verification:" + err.Error())
+	}
+	logger.Debug("Done")
+	return tokens
+}
+
+// serve the OpenAPI document describing the REST gateway
+func serveOpenAPI(logger *logging.Logger) http.HandlerFunc {
+	spec := envMust("OPENAPI_SPEC_FILE")
+	if _, err := os.Stat(spec); err != nil {
+		logger.Fatal("OpenAPI document missing:" + err.Error())
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		http.ServeFile(w, r, spec)
+	}
+}
+
+// serve wallboard snapshots as server-sent events. Like the REST gateway it is a client of the
+// gRPC server on the same port, so streams pass through the same interceptors. The tenant is
+// only taken from the x-tenant-id header, browsers must read the stream with fetch rather than
+// an EventSource, which cannot set headers.
+func serveWallboardEvents(logger *logging.Logger) http.HandlerFunc {
+	conn, err := grpc.Dial("localhost:"+envMust("PORT"), grpc.WithInsecure())
+	if err != nil {
+		sentry.CaptureException(err)
+		logger.Fatal("Failed to initialize wallboard events:" + err.Error())
+	}
+	client := pb.NewCallhandlingClient(conn)
+	marshaler := &runtime.JSONPb{OrigName: true, EmitDefaults: true}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+			return
+		}
+
+		tenantID := r.Header.Get(handlers.TenantHeader)
+		if tenantID == "" {
+			http.Error(w, "missing "+handlers.TenantHeader+" header", http.StatusUnauthorized)
+			return
+		}
+		interval, _ := strconv.Atoi(r.URL.Query().Get("interval_seconds"))
+
+		ctx := metadata.AppendToOutgoingContext(r.Context(), handlers.TenantHeader, tenantID)
+		if token := r.Header.Get("Authorization"); token != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, handlers.AuthHeader, token)
+		}
+		stream, err := client.WatchWallboard(ctx, &pb.WallboardRequest{IntervalSeconds: int32(interval)})
+		if err != nil {
+			http.Error(w, err.Error(), runtime.HTTPStatusFromCode(status.Code(err)))
+			return
+		}
+
+		// the first snapshot settles the status, so a rejected tenant is not sent as an event stream
+		snapshot, err := stream.Recv()
+		if err != nil {
+			http.Error(w, status.Convert(err).Message(), runtime.HTTPStatusFromCode(status.Code(err)))
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+
+		for {
+			data, err := marshaler.Marshal(snapshot)
+			if err != nil {
+				logger.Error("Error encoding wallboard snapshot:" + err.Error())
+				return
+			}
+			if _, err = fmt.Fprintf(w, "event: wallboard\ndata: %s\n\n", data); err != nil {
+				return
+			}
+			flusher.Flush()
+
+			if snapshot, err = stream.Recv(); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -31,38 +31,198 @@ func (s *service) Ping(ctx context.Context, in *pb.PingRequest) (*pb.PingRespons
 }
 
 func (s *service) CreateCall(ctx context.Context, in *pb.CallRequest) (*pb.CallResponse, error) {
-	return handlers.CreateCall(ctx, in, store.Calls)
+	return handlers.CreateCall(ctx, in, store.Calls, store.Events, store.Blocks)
+}
+
+func (s *service) GetCallerHistory(ctx context.Context, in *pb.CallerHistoryRequest) (*pb.CallerHistory, error) {
+	return handlers.GetCallerHistory(ctx, in, store.Calls, store.Events)
+}
+
+func (s *service) ListCallsByNumber(ctx context.Context, in *pb.CallsByNumberRequest) (*pb.CallsResponse, error) {
+	return handlers.ListCallsByNumber(ctx, in, store.Calls)
+}
+
+func (s *service) ListCalls(ctx context.Context, in *pb.ListCallsRequest) (*pb.CallsResponse, error) {
+	return handlers.ListCalls(ctx, in, store.Calls)
+}
+
+func (s *service) SetLegalHold(ctx context.Context, in *pb.LegalHoldRequest) (*pb.LegalHoldResponse, error) {
+	return handlers.SetLegalHold(ctx, in, store.Calls)
+}
+
+func (s *service) EraseCaller(ctx context.Context, in *pb.EraseCallerRequest) (*pb.ErasureReceipt, error) {
+	return handlers.EraseCaller(ctx, in, store.Erasures)
+}
+
+func (s *service) GetCDR(ctx context.Context, in *pb.CDRRequest) (*pb.CDR, error) {
+	return handlers.GetCDR(ctx, in, store.CDRs)
+}
+
+func (s *service) GetConversation(ctx context.Context, in *pb.ConversationRequest) (*pb.ConversationResponse, error) {
+	return handlers.GetConversation(ctx, in, store.Conversations)
+}
+
+func (s *service) LinkCall(ctx context.Context, in *pb.ConversationCallRequest) (*pb.CallResponse, error) {
+	return handlers.LinkCall(ctx, in, store.Conversations, store.Calls)
+}
+
+func (s *service) UnlinkCall(ctx context.Context, in *pb.ConversationCallRequest) (*pb.CallResponse, error) {
+	return handlers.UnlinkCall(ctx, in, store.Conversations, store.Calls)
+}
+
+func (s *service) GetHoldReport(ctx context.Context, in *pb.HoldReportRequest) (*pb.HoldReport, error) {
+	return handlers.GetHoldReport(ctx, in, store.Events)
+}
+
+func (s *service) ListCallbacks(ctx context.Context, in *pb.ListCallbacksRequest) (*pb.CallbacksResponse, error) {
+	return handlers.ListCallbacks(ctx, in, store.Callbacks)
+}
+
+func (s *service) ClaimCallback(ctx context.Context, in *pb.ClaimCallbackRequest) (*pb.Callback, error) {
+	return handlers.ClaimCallback(ctx, in, store.Callbacks)
+}
+
+func (s *service) CompleteCallback(ctx context.Context, in *pb.CompleteCallbackRequest) (*pb.Callback, error) {
+	return handlers.CompleteCallback(ctx, in, store.Callbacks, store.Calls, store.Conversations, store.Blocks)
+}
+
+func (s *service) GetAgentState(ctx context.Context, in *pb.AgentStateRequest) (*pb.AgentState, error) {
+	return handlers.GetAgentState(ctx, in, store.Agents)
+}
+
+func (s *service) ListAgentStates(ctx context.Context, in *pb.ListAgentStatesRequest) (*pb.AgentStatesResponse, error) {
+	return handlers.ListAgentStates(ctx, in, store.Agents)
+}
+
+func (s *service) SetAgentPresence(ctx context.Context, in *pb.AgentPresenceRequest) (*pb.AgentState, error) {
+	return handlers.SetAgentPresence(ctx, in, store.Agents)
+}
+
+func (s *service) GetAgentStateHistory(ctx context.Context, in *pb.AgentStateHistoryRequest) (*pb.AgentStateHistory, error) {
+	return handlers.GetAgentStateHistory(ctx, in, store.Agents)
+}
+
+func (s *service) GetAgentStats(ctx context.Context, in *pb.AgentStatsRequest) (*pb.AgentStats, error) {
+	return handlers.GetAgentStats(ctx, in, store.Events, store.Agents)
+}
+
+func (s *service) GetServiceLevelReport(ctx context.Context, in *pb.ServiceLevelReportRequest) (*pb.ServiceLevelReport, error) {
+	return handlers.GetServiceLevelReport(ctx, in, store.Events, store.Calls)
+}
+
+func (s *service) RecordEvent(ctx context.Context, in *pb.EventRequest) (*pb.EventResponse, error) {
+	return handlers.RecordEvent(ctx, in, store.Events, eventTypes)
+}
+
+func (s *service) RecordEvents(stream pb.Callhandling_RecordEventsServer) error {
+	return handlers.RecordEvents(stream, store.Events, eventTypes)
+}
+
+func (s *service) RecordEventBatch(ctx context.Context, in *pb.EventBatchRequest) (*pb.EventsResponse, error) {
+	return handlers.RecordEventBatch(ctx, in, store.Events, eventTypes)
 }
 
 func (s *service) Dialed(ctx context.Context, in *pb.EventRequest) (*pb.EventResponse, error) {
-	return handlers.Dialed(ctx, in, store.Events)
+	return handlers.Dialed(ctx, in, store.Events, eventTypes)
 }
 
 func (s *service) Ringed(ctx context.Context, in *pb.EventRequest) (*pb.EventResponse, error) {
-	return handlers.Ringed(ctx, in, store.Events)
+	return handlers.Ringed(ctx, in, store.Events, eventTypes)
 }
 
 func (s *service) Connected(ctx context.Context, in *pb.EventRequest) (*pb.EventResponse, error) {
-	return handlers.Connected(ctx, in, store.Events)
+	return handlers.Connected(ctx, in, store.Events, eventTypes)
 }
 
 func (s *service) Disconnected(ctx context.Context, in *pb.EventRequest) (*pb.EventResponse, error) {
-	return handlers.Disconnected(ctx, in, store.Events)
+	return handlers.Disconnected(ctx, in, store.Events, eventTypes)
 }
 
 func (s *service) Joined(ctx context.Context, in *pb.EventRequest) (*pb.EventResponse, error) {
-	return handlers.Joined(ctx, in, store.Events)
+	return handlers.Joined(ctx, in, store.Events, eventTypes)
 }
 
 func (s *service) Exited(ctx context.Context, in *pb.EventRequest) (*pb.EventResponse, error) {
-	return handlers.Exited(ctx, in, store.Events)
+	return handlers.Exited(ctx, in, store.Events, eventTypes)
 }
 
 func (s *service) Dispositioned(ctx context.Context, in *pb.EventRequest) (*pb.EventResponse, error) {
-	return handlers.Dispositioned(ctx, in, store.Events)
+	return handlers.Dispositioned(ctx, in, store.Events, eventTypes)
 }
 
 func (s *service) Enqueued(ctx context.Context, in *pb.EventRequest) (*pb.EventResponse, error) {
-	return handlers.Enqueued(ctx, in, store.Events)
+	return handlers.Enqueued(ctx, in, store.Events, eventTypes)
+
+}
+
+func (s *service) WatchWallboard(in *pb.WallboardRequest, stream pb.Callhandling_WatchWallboardServer) error {
+	return handlers.WatchWallboard(in, stream, wallboard)
+}
+
+func (s *service) CreateWebhookSubscription(ctx context.Context, in *pb.WebhookSubscriptionRequest) (*pb.WebhookSubscription, error) {
+	return handlers.CreateWebhookSubscription(ctx, in, store.Webhooks, eventTypes)
+}
+
+func (s *service) GetWebhookSubscription(ctx context.Context, in *pb.WebhookRequest) (*pb.WebhookSubscription, error) {
+	return handlers.GetWebhookSubscription(ctx, in, store.Webhooks)
+}
+
+func (s *service) ListWebhookSubscriptions(ctx context.Context, in *pb.ListWebhookSubscriptionsRequest) (*pb.WebhookSubscriptionsResponse, error) {
+	return handlers.ListWebhookSubscriptions(ctx, in, store.Webhooks)
+}
+
+func (s *service) UpdateWebhookSubscription(ctx context.Context, in *pb.WebhookSubscriptionRequest) (*pb.WebhookSubscription, error) {
+	return handlers.UpdateWebhookSubscription(ctx, in, store.Webhooks, eventTypes)
+}
+
+func (s *service) DeleteWebhookSubscription(ctx context.Context, in *pb.WebhookRequest) (*pb.WebhookSubscription, error) {
+	return handlers.DeleteWebhookSubscription(ctx, in, store.Webhooks)
+}
+
+func (s *service) ListWebhookDeliveries(ctx context.Context, in *pb.ListWebhookDeliveriesRequest) (*pb.WebhookDeliveriesResponse, error) {
+	return handlers.ListWebhookDeliveries(ctx, in, store.Webhooks)
+}
+
+func (s *service) RedeliverWebhook(ctx context.Context, in *pb.RedeliverWebhookRequest) (*pb.WebhookDelivery, error) {
+	return handlers.RedeliverWebhook(ctx, in, store.Webhooks)
+}
+
+func (s *service) CreateNumberBlock(ctx context.Context, in *pb.NumberBlockRequest) (*pb.NumberBlock, error) {
+	return handlers.CreateNumberBlock(ctx, in, store.Blocks)
+}
+
+func (s *service) ImportNumberBlocks(ctx context.Context, in *pb.ImportNumberBlocksRequest) (*pb.ImportNumberBlocksResponse, error) {
+	return handlers.ImportNumberBlocks(ctx, in, store.Blocks)
+}
+
+func (s *service) ListNumberBlocks(ctx context.Context, in *pb.ListNumberBlocksRequest) (*pb.NumberBlocksResponse, error) {
+	return handlers.ListNumberBlocks(ctx, in, store.Blocks)
+}
+
+func (s *service) DeleteNumberBlock(ctx context.Context, in *pb.DeleteNumberBlockRequest) (*pb.NumberBlock, error) {
+	return handlers.DeleteNumberBlock(ctx, in, store.Blocks)
+}
+
+func (s *service) ListBlockedCalls(ctx context.Context, in *pb.ListBlockedCallsRequest) (*pb.BlockedCallsResponse, error) {
+	return handlers.ListBlockedCalls(ctx, in, store.Blocks)
+}
+
+func (s *service) Route(ctx context.Context, in *pb.RouteRequest) (*pb.RoutingDecision, error) {
+	return handlers.Route(ctx, in, store.Routing, store.Calls, store.Events)
+}
+
+func (s *service) CreateRoutingRules(ctx context.Context, in *pb.RoutingRulesRequest) (*pb.RoutingRuleSet, error) {
+	return handlers.CreateRoutingRules(ctx, in, store.Routing)
+}
+
+func (s *service) GetRoutingRules(ctx context.Context, in *pb.GetRoutingRulesRequest) (*pb.RoutingRuleSet, error) {
+	return handlers.GetRoutingRules(ctx, in, store.Routing)
+}
+
+func (s *service) ListRoutingRuleVersions(ctx context.Context, in *pb.ListRoutingRuleVersionsRequest) (*pb.RoutingRuleVersionsResponse, error) {
+	return handlers.ListRoutingRuleVersions(ctx, in, store.Routing)
+}
 
+func (s *service) ExportCalls(in *pb.ExportCallsRequest, stream pb.Callhandling_ExportCallsServer) error {
+	return handlers.ExportCalls(in, stream, store.Calls)
 }
@@ -0,0 +1,142 @@
+package main
+
+// This file contains background jobs run alongside the gRPC and HTTP servers
+import (
+	"context"
+	"time"
+
+	"github.com/caring/call-handling/internal/handlers"
+	"github.com/caring/call-handling/internal/reaper"
+	"github.com/caring/call-handling/internal/redact"
+	"github.com/caring/call-handling/internal/retention"
+	"github.com/caring/call-handling/internal/webhook"
+	"github.com/caring/call-handling/pb"
+	"github.com/caring/go-packages/pkg/logging"
+	"github.com/getsentry/sentry-go"
+)
+
+// rekeyBatchSize is the number of rows re-encrypted per query
+const rekeyBatchSize = 500
+
+// runRekeyJob periodically re-encrypts the rows of every table whose PII is not sealed under
+// the current master key, so that old keys can be retired after a rotation
+func runRekeyJob(logger *logging.Logger, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		ctx := context.Background()
+		for name, rekey := range store.Rekeyers() {
+			// rows that cannot be decrypted stay stale, so each batch starts past those skipped
+			total, offset := 0, 0
+			for {
+				n, skipped, err := rekey(ctx, offset, rekeyBatchSize)
+				total += n
+				offset += len(skipped)
+				for _, s := range skipped {
+					sentry.CaptureException(s.Err)
+					logger.Error("Error re-encrypting "+name+", skipping the row:"+redact.Text(s.Err.Error()), logging.Int64("id", s.ID))
+				}
+				if err != nil {
+					sentry.CaptureException(err)
+					logger.Error("Error re-encrypting " + name + ":" + err.Error())
+					break
+				}
+				if n == 0 && len(skipped) == 0 {
+					break
+				}
+			}
+			if total > 0 || offset > 0 {
+				logger.Info("Re-encrypted PII", logging.String("table", name), logging.Int64("rows", int64(total)),
+					logging.Int64("skipped", int64(offset)))
+			}
+		}
+		<-ticker.C
+	}
+}
+
+// runRetentionJob periodically purges call data past each tenant's retention period
+// and reports what was purged to the reporting stream
+func runRetentionJob(logger *logging.Logger, interval time.Duration) {
+	purger := retention.NewPurger(store.Tenants, store.Retention)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		reports, err := purger.Run(context.Background())
+		if err != nil {
+			sentry.CaptureException(err)
+			logger.Error("Error purging expired data:" + err.Error())
+		}
+		for _, r := range reports {
+			logger.Report("Retention purge",
+				logging.String("tenant_id", r.TenantID),
+				logging.String("class", r.Class),
+				logging.String("cutoff", r.Cutoff.UTC().Format(time.RFC3339)),
+				logging.Int64("rows", r.Rows),
+			)
+		}
+		logger.Info("Retention purge complete", logging.Int64("reports", int64(len(reports))))
+		<-ticker.C
+	}
+}
+
+// runReaperJob periodically disconnects calls that never received a disconnect within
+// maxDuration and reports how many were reaped to the reporting stream
+func runReaperJob(logger *logging.Logger, interval, maxDuration time.Duration) {
+	record := func(ctx context.Context, in *pb.EventRequest) (*pb.EventResponse, error) {
+		return handlers.RecordEvent(ctx, in, store.Events, eventTypes)
+	}
+	r := reaper.NewReaper(store.Tenants, store.Calls, record, maxDuration)
+	r.OnSkip = func(ctx context.Context, callID int64, err error) {
+		sentry.CaptureException(err)
+		logger.Error("Error reaping stale call, skipping it:"+redact.Text(err.Error()), logging.Int64("call_id", callID))
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		reports, err := r.Run(context.Background())
+		if err != nil {
+			sentry.CaptureException(err)
+			logger.Error("Error reaping stale calls:" + err.Error())
+		}
+		var total int64
+		for _, rp := range reports {
+			logger.Report("Stale call reap",
+				logging.String("tenant_id", rp.TenantID),
+				logging.String("cutoff", rp.Cutoff.UTC().Format(time.RFC3339)),
+				logging.Int64("calls", rp.Calls),
+				logging.Int64("skipped", rp.Skipped),
+			)
+			total += rp.Calls
+		}
+		logger.Info("Stale call reap complete", logging.Int64("calls", total))
+		<-ticker.C
+	}
+}
+
+// runWebhookJob periodically posts due webhook deliveries, retrying failed ones, and
+// reports the results to the reporting stream
+func runWebhookJob(logger *logging.Logger, interval time.Duration) {
+	d := webhook.NewDeliverer(store.Tenants, store.Webhooks)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		reports, err := d.Run(context.Background())
+		if err != nil {
+			sentry.CaptureException(err)
+			logger.Error("Error delivering webhooks:" + err.Error())
+		}
+		for _, r := range reports {
+			logger.Report("Webhook delivery",
+				logging.String("tenant_id", r.TenantID),
+				logging.Int64("delivered", r.Delivered),
+				logging.Int64("retried", r.Retried),
+				logging.Int64("dead", r.Dead),
+			)
+		}
+		<-ticker.C
+	}
+}
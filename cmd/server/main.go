@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/internal/handlers"
+	"github.com/caring/call-handling/internal/redact"
 
 	"github.com/caring/call-handling/pb"
 	"github.com/caring/go-packages/pkg/logging"
@@ -21,6 +23,9 @@ import (
 
 var (
 	store        *db.Store
+	completer    *handlers.Completer
+	eventTypes   *handlers.EventRegistry
+	wallboard    *handlers.Wallboard
 	dbConnection string
 )
 
@@ -35,14 +40,37 @@ var (
 
 func init() {
 	l = initLogger()
+	initRedaction(l)
 	initSentry(l)
 
 	dbConnection = setDBConnectionString(l)
 	migrateDatabase(l, dbConnection)
-	store = initStore(l, dbConnection)
+	store = initStore(l, dbConnection, initEncrypter(l))
+	completer = handlers.NewCompleter(store.Calls, store.Events, store.CDRs, reportCDR(l))
+	classifier := handlers.NewClassifier(store.Events, store.Calls)
+	callbacks := handlers.NewCallbackScheduler(store.Calls, store.Callbacks)
+	eventTypes = initEventTypes(l,
+		handlers.BuiltinEventTypes(completer, classifier, callbacks),
+		handlers.OutcomeEventTypes(classifier),
+		handlers.CallbackEventTypes(callbacks),
+		handlers.TransferEventTypes(store.Calls, store.Conversations),
+		handlers.HoldEventTypes(),
+	)
+	eventTypes.HandleEffectErrors(reportEffectError(l))
+	if err := handlers.AttachAgentStates(eventTypes, store.Agents); err != nil {
+		l.Fatal("Failed to attach agent states:" + err.Error())
+	}
+	if err := handlers.AttachWebhooks(eventTypes, handlers.NewWebhookDispatcher(store.Webhooks, store.Calls)); err != nil {
+		l.Fatal("Failed to attach webhooks:" + err.Error())
+	}
+	wallboard = handlers.NewWallboard(store.Events, store.Calls, store.Agents)
 
+	tokens := initTokenVerifier(l)
 	t = initTracing(l)
-	g = createGRPCServer(l, t)
+	g = createGRPCServer(l, t,
+		[]grpc.UnaryServerInterceptor{redact.UnaryServerInterceptor(), handlers.NewTenantUnaryInterceptor(store.Tenants, tokens)},
+		[]grpc.StreamServerInterceptor{redact.StreamServerInterceptor(), handlers.NewTenantStreamInterceptor(store.Tenants, tokens)},
+	)
 }
 
 func main() {
@@ -73,6 +101,12 @@ func main() {
 		w.WriteHeader(http.StatusOK)
 	})
 
+	// serve the REST/JSON gateway and its OpenAPI document alongside the health check
+	http.Handle("/v1/", createGateway(l))
+	http.HandleFunc("/openapi.json", serveOpenAPI(l))
+	// stream wallboard snapshots to browsers as server-sent events
+	http.HandleFunc("/v1/wallboard/events", serveWallboardEvents(l))
+
 	// make an error channel to collect the exits of each protocol's Serve()
 	eChan := make(chan error)
 
@@ -89,6 +123,12 @@ func main() {
 	// serve it up
 	go func() { eChan <- m.Serve() }()
 
+	// background maintenance
+	go runRekeyJob(l, envDuration("PII_REKEY_INTERVAL"))
+	go runRetentionJob(l, envDuration("RETENTION_INTERVAL"))
+	go runReaperJob(l, envDuration("REAPER_INTERVAL"), envDuration("REAPER_MAX_CALL_DURATION"))
+	go runWebhookJob(l, envDuration("WEBHOOK_INTERVAL"))
+
 	for err := range eChan {
 		if err != nil {
 			sentry.CaptureException(err)
@@ -113,3 +153,15 @@ func envMust(varName string) string {
 	}
 	return value
 }
+
+// fetches and parses the given env variable as a duration, fatals
+// if the variable is missing or malformed
+func envDuration(varName string) time.Duration {
+	d, err := time.ParseDuration(envMust(varName))
+	if err != nil {
+		e := errors.New("environment variable malformed - " + varName)
+		sentry.CaptureException(e)
+		l.Fatal(e.Error())
+	}
+	return d
+}
@@ -6,6 +6,8 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/caring/call-handling/internal/redact"
+
 	"github.com/caring/go-packages/pkg/grpc_middleware"
 	"github.com/caring/go-packages/pkg/logging"
 	"github.com/caring/go-packages/pkg/tracing"
@@ -50,6 +52,7 @@ func initSentry(logger *logging.Logger) {
 	err = sentry.Init(sentry.ClientOptions{
 		Dsn:         sentryDsn,
 		Environment: env,
+		BeforeSend:  redact.BeforeSend,
 	})
 	if err != nil {
 		logger.Fatal("sentry.Init:" + err.Error())
@@ -73,15 +76,17 @@ func initTracing(logger *logging.Logger) *tracing.Tracer {
 }
 
 // create protocol server with chained interceptors
-func createGRPCServer(logger *logging.Logger, tracer *tracing.Tracer) *grpc.Server {
+func createGRPCServer(logger *logging.Logger, tracer *tracing.Tracer, unary []grpc.UnaryServerInterceptor, stream []grpc.StreamServerInterceptor) *grpc.Server {
 	return grpc.NewServer(
 		grpc_middleware.NewGRPCChainedUnaryInterceptor(grpc_middleware.UnaryOptions{
-			Logger: logger,
-			Tracer: tracer,
+			Logger:       logger,
+			Tracer:       tracer,
+			Interceptors: unary,
 		}),
 		grpc_middleware.NewGRPCChainedStreamInterceptor(grpc_middleware.StreamOptions{
-			Logger: logger,
-			Tracer: tracer,
+			Logger:       logger,
+			Tracer:       tracer,
+			Interceptors: stream,
 		}),
 	)
 }
@@ -96,7 +101,7 @@ func setDBConnectionString(logger *logging.Logger) string {
 	port := envMust("DB_PORT")
 	schema := envMust("DB_SCHEMA")
 	logger.Debug("Done")
-	return user + ":" + pwd + "@tcp(" + host + ":" + port + ")/" + schema
+	return user + ":" + pwd + "@tcp(" + host + ":" + port + ")/" + schema + "?parseTime=true"
 }
 
 // perform the database migration from env config
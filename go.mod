@@ -4,14 +4,20 @@ go 1.14
 
 require (
 	github.com/DATA-DOG/go-sqlmock v1.5.0
+	github.com/aws/aws-sdk-go v1.31.5
 	github.com/caring/go-packages v1.7.0
 	github.com/getsentry/sentry-go v0.7.0
 	github.com/go-sql-driver/mysql v1.5.0
 	github.com/golang-migrate/migrate/v4 v4.13.0
 	github.com/golang/protobuf v1.4.2
 	github.com/google/uuid v1.1.2
+	github.com/grpc-ecosystem/grpc-gateway v1.14.8
+	github.com/nyaruka/phonenumbers v1.0.55
 	github.com/soheilhy/cmux v0.1.4
 	github.com/stretchr/testify v1.6.0
+	github.com/xitongsys/parquet-go v1.5.1
+	github.com/xitongsys/parquet-go-source v0.0.0-20190524061010-2b72cbee77d5
+	google.golang.org/genproto v0.0.0-20200815001618-f69a88009b70
 	google.golang.org/grpc v1.32.0
 	google.golang.org/protobuf v1.25.0
 )
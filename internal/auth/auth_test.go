@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/caring/go-packages/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifier_Verify(t *testing.T) {
+	v, err := NewVerifier(bytes.Repeat([]byte{1}, 32))
+	if ok := assert.NoError(t, err, "Expected no error"); !ok {
+		assert.FailNow(t, "verifier setup failed")
+	}
+	now := time.Unix(1000, 0)
+	v.now = func() time.Time { return now }
+
+	token, err := v.Sign(&Claims{Subject: "ops@example.com", TenantID: "a", Role: "admin", ExpiresAt: 2000})
+	assert.NoError(t, err, "Expected no error")
+
+	// ensures that a token signed with the key is accepted with its claims
+	c, err := v.Verify(token)
+	assert.NoError(t, err, "Expected no error")
+	assert.Equal(t, &Claims{Subject: "ops@example.com", TenantID: "a", Role: "admin", ExpiresAt: 2000}, c, "Expected the claims")
+
+	// ensures that a token signed with another key is refused
+	other, _ := NewVerifier(bytes.Repeat([]byte{2}, 32))
+	forged, _ := other.Sign(&Claims{TenantID: "a", Role: "admin", ExpiresAt: 2000})
+	_, err = v.Verify(forged)
+	assert.True(t, errors.Is(err, ErrInvalidToken), "Expected a forged token to be refused")
+
+	// ensures that changing the claims breaks the signature
+	parts := strings.Split(token, ".")
+	parts[1] = b64.EncodeToString([]byte(`{"tenant_id":"b","role":"admin","exp":2000}`))
+	_, err = v.Verify(strings.Join(parts, "."))
+	assert.True(t, errors.Is(err, ErrInvalidToken), "Expected a tampered token to be refused")
+
+	// ensures that an expired token is refused
+	now = time.Unix(2000, 0)
+	_, err = v.Verify(token)
+	assert.True(t, errors.Is(err, ErrExpiredToken), "Expected an expired token to be refused")
+
+	_, err = v.Verify("not-a-token")
+	assert.True(t, errors.Is(err, ErrInvalidToken), "Expected a malformed token to be refused")
+}
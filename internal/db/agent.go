@@ -0,0 +1,228 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+// agentService provides an API for interacting with the agent_states and agent_state_history tables
+type agentService struct {
+	db    *sql.DB
+	stmts map[string]*sql.Stmt
+}
+
+// AgentState is a struct representation of a row in the agent_states table, the state an
+// agent has been in since a unix time
+type AgentState struct {
+	TenantID   string
+	IdentityID int64
+	State      string
+	// CallID is the call the state concerns, 0 for states outside of a call
+	CallID int64
+	Since  int64
+}
+
+// AgentStatePeriod is a struct representation of a row in the agent_state_history table,
+// a period in unix seconds an agent spent in a state
+type AgentStatePeriod struct {
+	IdentityID int64
+	State      string
+	CallID     int64
+	StartedAt  int64
+	EndedAt    int64
+}
+
+// Get fetches the current state of a single agent
+func (svc *agentService) Get(ctx context.Context, identityID int64) (*AgentState, error) {
+	errMsg := func() string { return "Error executing get agent state - " + fmt.Sprint(identityID) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	p, err := scanAgentState(svc.stmts["get-agent-state"].QueryRowContext(ctx, tenant.ID, identityID))
+	if err != nil {
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrap(ErrNotFound, errMsg())
+		}
+
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return p, nil
+}
+
+// List fetches the current state of every agent, or of the agents in state if it is set
+func (svc *agentService) List(ctx context.Context, state string) ([]*AgentState, error) {
+	errMsg := func() string { return "Error executing list agent states - " + state }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return svc.list(ctx, errMsg, "list-agent-states", tenant.ID, state, state)
+}
+
+// ListByCall fetches the agents whose current state concerns a call
+func (svc *agentService) ListByCall(ctx context.Context, callID int64) ([]*AgentState, error) {
+	errMsg := func() string { return "Error executing list agent states by call - " + fmt.Sprint(callID) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return svc.list(ctx, errMsg, "list-agent-states-by-call", tenant.ID, callID)
+}
+
+// list runs a statement selecting agent states
+func (svc *agentService) list(ctx context.Context, errMsg func() string, stmt string, args ...interface{}) ([]*AgentState, error) {
+	rows, err := svc.stmts[stmt].QueryContext(ctx, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+	defer rows.Close()
+
+	states := []*AgentState{}
+	for rows.Next() {
+		p, err := scanAgentState(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errMsg())
+		}
+		states = append(states, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return states, nil
+}
+
+// Transition moves an agent into a new state in a single transaction, closing their
+// previous state into the history. A transition to the state and call the agent is already
+// in changes nothing, and one stamped before the previous state began starts when it did.
+func (svc *agentService) Transition(ctx context.Context, next *AgentState) (changed bool, err error) {
+	errMsg := func() string { return "Error executing agent state transition - " + fmt.Sprint(next.IdentityID) }
+
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, errors.Wrap(err, errMsg())
+	}
+
+	if changed, err = svc.transition(ToCtx(ctx, tx), next); err != nil {
+		tx.Rollback()
+		return false, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return false, errors.Wrap(err, errMsg())
+	}
+
+	return changed, nil
+}
+
+// TransitionTx moves an agent into a new state within a tx from ctx
+func (svc *agentService) TransitionTx(ctx context.Context, next *AgentState) (bool, error) {
+	return svc.transition(ctx, next)
+}
+
+// transition moves an agent into a new state within the tx from ctx
+func (svc *agentService) transition(ctx context.Context, next *AgentState) (bool, error) {
+	errMsg := func() string { return "Error executing agent state transition - " + fmt.Sprint(next.IdentityID) }
+
+	tx, err := FromCtx(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, errMsg())
+	}
+	next.TenantID = tenant.ID
+
+	prev, err := scanAgentState(tx.Stmt(svc.stmts["lock-agent-state"]).QueryRowContext(ctx, tenant.ID, next.IdentityID))
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return false, errors.Wrap(err, errMsg())
+	}
+
+	if prev != nil {
+		if prev.State == next.State && prev.CallID == next.CallID {
+			return false, nil
+		}
+		if next.Since < prev.Since {
+			next.Since = prev.Since
+		}
+
+		_, err = tx.Stmt(svc.stmts["create-agent-state-period"]).ExecContext(ctx,
+			tenant.ID, prev.IdentityID, prev.State, nullInt64(prev.CallID), prev.Since, next.Since)
+		if err != nil {
+			return false, errors.Wrap(err, errMsg())
+		}
+	}
+
+	_, err = tx.Stmt(svc.stmts["upsert-agent-state"]).ExecContext(ctx,
+		tenant.ID, next.IdentityID, next.State, nullInt64(next.CallID), next.Since)
+	if err != nil {
+		return false, errors.Wrap(err, errMsg())
+	}
+
+	return true, nil
+}
+
+// History fetches the completed periods of an agent that overlap the time range from to to
+func (svc *agentService) History(ctx context.Context, identityID, from, to int64) ([]*AgentStatePeriod, error) {
+	errMsg := func() string { return "Error executing list agent state history - " + fmt.Sprint(identityID) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	rows, err := svc.stmts["list-agent-state-history"].QueryContext(ctx, tenant.ID, identityID, to, from)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+	defer rows.Close()
+
+	periods := []*AgentStatePeriod{}
+	for rows.Next() {
+		var (
+			p      = AgentStatePeriod{}
+			callID sql.NullInt64
+		)
+		if err = rows.Scan(&p.IdentityID, &p.State, &callID, &p.StartedAt, &p.EndedAt); err != nil {
+			return nil, errors.Wrap(err, errMsg())
+		}
+		p.CallID = callID.Int64
+		periods = append(periods, &p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return periods, nil
+}
+
+// scanAgentState reads an agent_states row
+func scanAgentState(row rowScanner) (*AgentState, error) {
+	var (
+		p      = AgentState{}
+		callID sql.NullInt64
+	)
+
+	if err := row.Scan(&p.TenantID, &p.IdentityID, &p.State, &callID, &p.Since); err != nil {
+		return nil, err
+	}
+	p.CallID = callID.Int64
+
+	return &p, nil
+}
@@ -0,0 +1,67 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAgent_transition(t *testing.T) {
+	stmt := map[string]string{
+		"lock-agent-state":          "SELECT (.+) FROM agent_states (.+) FOR UPDATE",
+		"create-agent-state-period": "INSERT INTO agent_state_history",
+		"upsert-agent-state":        "INSERT INTO agent_states",
+	}
+	ctx := TenantToCtx(context.Background(), &Tenant{ID: "tenant-a"})
+	columns := []string{"tenant_id", "identity_id", "state", "call_id", "since"}
+
+	// ensures that the previous state is closed into the history, ending when the next begins
+	t.Run("From a previous state", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT (.+) FROM agent_states (.+) FOR UPDATE").
+			WithArgs("tenant-a", int64(7)).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow("tenant-a", 7, "ringing", 1000, 100))
+		mock.ExpectExec("INSERT INTO agent_state_history").
+			WithArgs("tenant-a", int64(7), "ringing", int64(1000), int64(100), int64(110)).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("INSERT INTO agent_states").
+			WithArgs("tenant-a", int64(7), "on_call", int64(1000), int64(110)).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		changed, err := store.Agents.Transition(ctx, &AgentState{IdentityID: 7, State: "on_call", CallID: 1000, Since: 110})
+		assert.NoError(t, err, "Expecting no query error")
+		assert.True(t, changed, "Expecting the state to change")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+
+	// ensures that a transition to the current state writes nothing
+	t.Run("To the current state", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT (.+) FROM agent_states (.+) FOR UPDATE").
+			WithArgs("tenant-a", int64(7)).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow("tenant-a", 7, "on_call", 1000, 110))
+		mock.ExpectCommit()
+
+		changed, err := store.Agents.Transition(ctx, &AgentState{IdentityID: 7, State: "on_call", CallID: 1000, Since: 120})
+		assert.NoError(t, err, "Expecting no query error")
+		assert.False(t, changed, "Expecting the state not to change")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+}
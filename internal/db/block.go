@@ -0,0 +1,343 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/caring/go-packages/pkg/errors"
+
+	"github.com/caring/call-handling/internal/fieldcrypt"
+	"github.com/caring/call-handling/internal/redact"
+	"github.com/caring/call-handling/pb"
+)
+
+// blockService provides an API for interacting with the number_blocks and blocked_calls tables
+type blockService struct {
+	db    *sql.DB
+	stmts map[string]*sql.Stmt
+	enc   *fieldcrypt.Encrypter
+}
+
+// NumberBlock is a struct representation of a row in the number_blocks table. An entry
+// blocks either a single number or every number starting with a prefix.
+type NumberBlock struct {
+	ID       int64
+	TenantID string
+	List     string
+	// Number is the E.164 number of an exact entry, Prefix the E.164 prefix of a prefix entry
+	Number    string
+	Prefix    string
+	Action    string
+	Reason    string
+	CreatedAt time.Time
+}
+
+// ToProto casts a db number block into a proto response object
+func (m *NumberBlock) ToProto() *pb.NumberBlock {
+	return &pb.NumberBlock{
+		BlockId:   m.ID,
+		List:      m.List,
+		Number:    m.Number,
+		Prefix:    m.Prefix,
+		Action:    m.Action,
+		Reason:    m.Reason,
+		CreatedAt: m.CreatedAt.Unix(),
+	}
+}
+
+// String formats a number block for logs and error messages, masking its number
+func (m *NumberBlock) String() string {
+	return fmt.Sprintf("&{%d, %s, %s, %s, %s, %s}",
+		m.ID, m.TenantID, m.List, redact.Field(redact.ANIE164, m.Number), m.Prefix, m.Action)
+}
+
+// GoString keeps %#v from bypassing String
+func (m *NumberBlock) GoString() string {
+	return m.String()
+}
+
+// BlockedCall is a struct representation of a row in the blocked_calls table
+type BlockedCall struct {
+	ID        int64
+	TenantID  string
+	CallID    int64
+	BlockID   int64
+	List      string
+	Action    string
+	CreatedAt time.Time
+}
+
+// ToProto casts a db blocked call into a proto response object
+func (m *BlockedCall) ToProto() *pb.BlockedCall {
+	return &pb.BlockedCall{
+		BlockedCallId: m.ID,
+		CallId:        m.CallID,
+		BlockId:       m.BlockID,
+		List:          m.List,
+		Action:        m.Action,
+		CreatedAt:     m.CreatedAt.Unix(),
+	}
+}
+
+// BlockedCallFilter selects the blocked calls recorded in [From, To), optionally of one list
+type BlockedCallFilter struct {
+	From time.Time
+	To   time.Time
+	List string
+}
+
+// Create stores a number block, setting its ID. Blocking a number or prefix already on
+// the list updates the action and reason of the existing entry instead.
+func (svc *blockService) Create(ctx context.Context, input *NumberBlock) error {
+	return svc.create(ctx, false, input)
+}
+
+// CreateBatch stores number blocks in a single transaction
+func (svc *blockService) CreateBatch(ctx context.Context, input []*NumberBlock) error {
+	errMsg := func() string { return "Error executing create number blocks - " + fmt.Sprint(len(input)) }
+
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	txCtx := ToCtx(ctx, tx)
+	for _, b := range input {
+		if err = svc.create(txCtx, true, b); err != nil {
+			tx.Rollback()
+			return errors.Wrap(err, errMsg())
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	return nil
+}
+
+// create stores a number block. if useTx = true then it will attempt to create the block
+// within a transaction from context.
+func (svc *blockService) create(ctx context.Context, useTx bool, input *NumberBlock) error {
+	errMsg := func() string { return "Error executing create number block - " + fmt.Sprint(input) }
+
+	var (
+		stmt *sql.Stmt
+		err  error
+		tx   *sql.Tx
+	)
+
+	if useTx {
+
+		if tx, err = FromCtx(ctx); err != nil {
+			return err
+		}
+
+		stmt = tx.Stmt(svc.stmts["create-number-block"])
+	} else {
+		stmt = svc.stmts["create-number-block"]
+	}
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+	input.TenantID = tenant.ID
+
+	// only exact entries hold a number to encrypt
+	var keyID string
+	number, err := svc.enc.Encrypt(ctx, input.Number)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+	if number != "" {
+		keyID = svc.enc.CurrentKeyID()
+	}
+
+	result, err := stmt.ExecContext(ctx, input.TenantID, input.List, nullString(number), nullString(svc.enc.BlindIndex(input.Number)),
+		nullString(input.Prefix), input.Action, nullString(input.Reason), nullString(keyID))
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	if input.ID, err = result.LastInsertId(); err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	return nil
+}
+
+// Get fetches a single number block
+func (svc *blockService) Get(ctx context.Context, ID int64) (*NumberBlock, error) {
+	errMsg := func() string { return "Error executing get number block - " + fmt.Sprint(ID) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	p, err := svc.scan(ctx, svc.stmts["get-number-block"].QueryRowContext(ctx, ID, tenant.ID))
+	if err != nil {
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrap(ErrNotFound, errMsg())
+		}
+
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return p, nil
+}
+
+// List fetches the most recent number blocks, of every list when list is empty
+func (svc *blockService) List(ctx context.Context, list string, limit int) ([]*NumberBlock, error) {
+	errMsg := func() string { return "Error executing list number blocks - " + list }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return svc.query(ctx, errMsg, "list-number-blocks", tenant.ID, list, list, limit)
+}
+
+// Match fetches the entries of a list blocking an E.164 number, either exactly or by prefix
+func (svc *blockService) Match(ctx context.Context, list, e164 string) ([]*NumberBlock, error) {
+	errMsg := func() string { return "Error executing match number blocks - " + redact.Field(redact.ANIE164, e164) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return svc.query(ctx, errMsg, "match-number-blocks", tenant.ID, list, svc.enc.BlindIndex(e164), e164)
+}
+
+// Delete removes a single number block
+func (svc *blockService) Delete(ctx context.Context, ID int64) error {
+	errMsg := func() string { return "Error executing delete number block - " + fmt.Sprint(ID) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	result, err := svc.stmts["delete-number-block"].ExecContext(ctx, ID, tenant.ID)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	rowCount, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	if rowCount == 0 {
+		return errors.Wrap(ErrNoRowsAffected, errMsg())
+	}
+
+	return nil
+}
+
+// RecordBlockedCall audits a call rejected or flagged by a number block. Only the blind
+// index of the matched number is stored.
+func (svc *blockService) RecordBlockedCall(ctx context.Context, input *BlockedCall, e164 string) error {
+	errMsg := func() string { return "Error executing create blocked call - " + fmt.Sprint(input.CallID) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+	input.TenantID = tenant.ID
+
+	result, err := svc.stmts["create-blocked-call"].ExecContext(ctx,
+		input.TenantID, input.CallID, input.BlockID, input.List, input.Action, svc.enc.BlindIndex(e164))
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	if input.ID, err = result.LastInsertId(); err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	return nil
+}
+
+// ListBlockedCalls fetches the most recent blocked calls matching filter
+func (svc *blockService) ListBlockedCalls(ctx context.Context, filter BlockedCallFilter, limit int) ([]*BlockedCall, error) {
+	errMsg := func() string { return "Error executing list blocked calls - " + filter.To.UTC().Format(time.RFC3339) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	rows, err := svc.stmts["list-blocked-calls"].QueryContext(ctx,
+		tenant.ID, filter.From.UTC(), filter.To.UTC(), filter.List, filter.List, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+	defer rows.Close()
+
+	blocked := []*BlockedCall{}
+	for rows.Next() {
+		p := BlockedCall{}
+		if err = rows.Scan(&p.ID, &p.TenantID, &p.CallID, &p.BlockID, &p.List, &p.Action, &p.CreatedAt); err != nil {
+			return nil, errors.Wrap(err, errMsg())
+		}
+		blocked = append(blocked, &p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return blocked, nil
+}
+
+// query runs a statement selecting number blocks
+func (svc *blockService) query(ctx context.Context, errMsg func() string, name string, args ...interface{}) ([]*NumberBlock, error) {
+	rows, err := svc.stmts[name].QueryContext(ctx, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+	defer rows.Close()
+
+	blocks := []*NumberBlock{}
+	for rows.Next() {
+		p, err := svc.scan(ctx, rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errMsg())
+		}
+		blocks = append(blocks, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return blocks, nil
+}
+
+// scan reads a number_blocks row, decrypting its number
+func (svc *blockService) scan(ctx context.Context, row rowScanner) (*NumberBlock, error) {
+	var (
+		p                      = NumberBlock{}
+		number, prefix, reason sql.NullString
+		keyID                  sql.NullString
+	)
+
+	err := row.Scan(&p.ID, &p.TenantID, &p.List, &number, &prefix, &p.Action, &reason, &keyID, &p.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	p.Prefix, p.Reason = prefix.String, reason.String
+
+	if p.Number, err = openPII(ctx, svc.enc, keyID, number.String); err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
@@ -0,0 +1,85 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlock_create(t *testing.T) {
+	stmt := map[string]string{
+		"create-number-block": "INSERT INTO number_blocks",
+	}
+
+	// ensures that an exact entry stores its number sealed, alongside its blind index
+	t.Run("Exact entry", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectExec("INSERT INTO number_blocks").
+			WithArgs("tenant-a", "dnc", sealedArg{"+15125551234"}, store.Blocks.enc.BlindIndex("+15125551234"),
+				nil, "reject", "asked", testKeyID).
+			WillReturnResult(sqlmock.NewResult(7, 1))
+
+		ctx := TenantToCtx(context.Background(), &Tenant{ID: "tenant-a"})
+		b := &NumberBlock{List: "dnc", Number: "+15125551234", Action: "reject", Reason: "asked"}
+		err = store.Blocks.Create(ctx, b)
+		assert.NoError(t, err, "Expecting no query error")
+		assert.Equal(t, int64(7), b.ID, "Expected the id to be set")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+
+	// ensures that a prefix entry has no number to seal
+	t.Run("Prefix entry", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectExec("INSERT INTO number_blocks").
+			WithArgs("tenant-a", "blocklist", nil, nil, "+1900", "flag", nil, nil).
+			WillReturnResult(sqlmock.NewResult(8, 1))
+
+		ctx := TenantToCtx(context.Background(), &Tenant{ID: "tenant-a"})
+		err = store.Blocks.Create(ctx, &NumberBlock{List: "blocklist", Prefix: "+1900", Action: "flag"})
+		assert.NoError(t, err, "Expecting no query error")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+}
+
+func TestBlock_match(t *testing.T) {
+	stmt := map[string]string{
+		"match-number-blocks": "SELECT number_blocks",
+	}
+	columns := []string{"block_id", "tenant_id", "list", "number", "prefix", "action", "reason", "pii_key_id", "created_at"}
+
+	store, mock, err := NewTestDB(stmt)
+	if ok := assert.NoError(t, err, "Expected no error"); !ok {
+		assert.FailNow(t, "test setup failed")
+	}
+
+	// ensures that the number is matched by its blind index and, for prefixes, in the clear
+	mock.ExpectQuery("SELECT number_blocks").
+		WithArgs("tenant-a", "blocklist", store.Blocks.enc.BlindIndex("+19005550100"), "+19005550100").
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(int64(8), "tenant-a", "blocklist", nil, "+1900", "reject", nil, nil, time.Unix(100, 0)))
+
+	ctx := TenantToCtx(context.Background(), &Tenant{ID: "tenant-a"})
+	blocks, err := store.Blocks.Match(ctx, "blocklist", "+19005550100")
+	assert.NoError(t, err, "Expecting no query error")
+	if assert.Len(t, blocks, 1, "Expected a single match") {
+		assert.Equal(t, "+1900", blocks[0].Prefix, "Expected the prefix to be read")
+	}
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err, "Expecting all mock conditions to be met")
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/caring/go-packages/pkg/errors"
 
+	"github.com/caring/call-handling/internal/fieldcrypt"
+	"github.com/caring/call-handling/internal/redact"
 	"github.com/caring/call-handling/pb"
 )
 
@@ -14,16 +17,41 @@ import (
 type callService struct {
 	db    *sql.DB
 	stmts map[string]*sql.Stmt
+	enc   *fieldcrypt.Encrypter
 }
 
 // Call is a struct representation of a row in the calls table
 type Call struct {
 	ID             int64
+	TenantID       string
 	SID            int64
 	ConversationID int64
 	ANI            string
 	DNIS           string
-	Status         string
+	ANIE164        string
+	DNISE164       string
+	// ANIInvalid and DNISInvalid are set when a number was given but could not be
+	// normalized, its E.164 form is then empty just as for a missing number
+	ANIInvalid  bool
+	DNISInvalid bool
+	// Direction is inbound or outbound, deciding which list the call was screened against.
+	// It and the number block that flagged the call, empty when none did, are set when the
+	// call is created and left alone by updates.
+	Direction   string
+	BlockID     int64
+	BlockAction string
+	Status      string
+	// Outcome is how the call ended, empty until it is classified
+	Outcome   string
+	CreatedAt time.Time
+}
+
+// outbound is the direction of calls placed to a party, whose number is then the DNIS
+const outbound = "outbound"
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
 }
 
 // protoCall is an interface that most proto call objects will satisfy
@@ -40,6 +68,7 @@ func NewCall(proto protoCall) *Call {
 		ConversationID: c.GetConversationId(),
 		ANI:            c.GetANI(),
 		DNIS:           c.GetDNIS(),
+		Direction:      c.GetDirection(),
 		Status:         c.GetStatus(),
 	}
 }
@@ -52,8 +81,133 @@ func (m *Call) ToProto() *pb.CallResponse {
 		ConversationId: m.ConversationID,
 		ANI:            m.ANI,
 		DNIS:           m.DNIS,
+		ANIE164:        m.ANIE164,
+		DNISE164:       m.DNISE164,
+		ANIInvalid:     m.ANIInvalid,
+		DNISInvalid:    m.DNISInvalid,
+		Direction:      m.Direction,
+		BlockId:        m.BlockID,
+		BlockAction:    m.BlockAction,
 		Status:         m.Status,
+		Outcome:        m.Outcome,
+	}
+}
+
+// String formats a call for logs and error messages, masking fields the redaction policy marks sensitive
+func (m *Call) String() string {
+	return fmt.Sprintf("&{%d, %s, %d, %d, %s, %s, %s, %s, %s}",
+		m.ID, m.TenantID, m.SID, m.ConversationID,
+		redact.Field(redact.ANI, m.ANI), redact.Field(redact.DNIS, m.DNIS),
+		redact.Field(redact.ANIE164, m.ANIE164), redact.Field(redact.DNISE164, m.DNISE164),
+		m.Status)
+}
+
+// GoString keeps %#v from bypassing String
+func (m *Call) GoString() string {
+	return m.String()
+}
+
+// scan reads a calls row selected in the column order used by the call statements,
+// decrypting its PII columns
+func (svc *callService) scan(ctx context.Context, row rowScanner) (*Call, error) {
+	var (
+		p                  = Call{}
+		sid, conversation  sql.NullInt64
+		blockID            sql.NullInt64
+		ani, dnis, status  sql.NullString
+		aniE164, dnisE164  sql.NullString
+		outcome, keyID     sql.NullString
+		blockAction, dHash sql.NullString
+	)
+
+	err := row.Scan(&p.ID, &p.TenantID, &sid, &conversation, &ani, &dnis, &aniE164, &dnisE164, &p.ANIInvalid, &p.DNISInvalid,
+		&p.Direction, &blockID, &blockAction, &dHash, &status, &outcome, &keyID, &p.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	p.SID, p.ConversationID = sid.Int64, conversation.Int64
+	p.BlockID, p.BlockAction = blockID.Int64, blockAction.String
+	p.Status, p.Outcome = status.String, outcome.String
+
+	if p.ANI, err = openPII(ctx, svc.enc, keyID, ani.String); err != nil {
+		return nil, err
+	}
+	if p.ANIE164, err = openPII(ctx, svc.enc, keyID, aniE164.String); err != nil {
+		return nil, err
 	}
+	if p.DNIS, p.DNISE164, err = openDNIS(ctx, svc.enc, keyID, dHash, dnis.String, dnisE164.String); err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// openDNIS decrypts the DNIS columns of a call, which are only encrypted once the call has a
+// DNIS blind index. Inbound calls, and outbound calls the re-encryption job has not reached
+// yet, are returned as stored.
+func openDNIS(ctx context.Context, enc *fieldcrypt.Encrypter, keyID, dnisHash sql.NullString, dnis, dnisE164 string) (string, string, error) {
+	if !dnisHash.Valid {
+		return dnis, dnisE164, nil
+	}
+
+	dnis, err := openPII(ctx, enc, keyID, dnis)
+	if err != nil {
+		return "", "", err
+	}
+	if dnisE164, err = openPII(ctx, enc, keyID, dnisE164); err != nil {
+		return "", "", err
+	}
+	return dnis, dnisE164, nil
+}
+
+// sealedCall holds the encrypted form of a call's PII columns
+type sealedCall struct {
+	ANI     string
+	ANIE164 string
+	ANIHash string
+	// DNIS and DNISE164 are encrypted and DNISHash set on outbound calls only
+	DNIS     string
+	DNISE164 string
+	DNISHash sql.NullString
+	KeyID    string
+}
+
+// seal encrypts a call's PII columns and computes the ANI blind index. The DNIS of an
+// outbound call is the party called, so it is encrypted and blind indexed as well.
+func (svc *callService) seal(ctx context.Context, input *Call) (*sealedCall, error) {
+	var (
+		sealed = sealedCall{DNIS: input.DNIS, DNISE164: input.DNISE164, KeyID: svc.enc.CurrentKeyID()}
+		err    error
+	)
+
+	if sealed.ANI, err = svc.enc.Encrypt(ctx, input.ANI); err != nil {
+		return nil, err
+	}
+	if sealed.ANIE164, err = svc.enc.Encrypt(ctx, input.ANIE164); err != nil {
+		return nil, err
+	}
+	sealed.ANIHash = svc.enc.BlindIndex(input.ANIE164)
+
+	if input.Direction == outbound {
+		if err = svc.sealDNIS(ctx, input, &sealed); err != nil {
+			return nil, err
+		}
+	}
+
+	return &sealed, nil
+}
+
+// sealDNIS encrypts a call's DNIS columns into sealed and computes the DNIS blind index,
+// which is set even when empty to mark the columns encrypted
+func (svc *callService) sealDNIS(ctx context.Context, input *Call, sealed *sealedCall) (err error) {
+	if sealed.DNIS, err = svc.enc.Encrypt(ctx, input.DNIS); err != nil {
+		return err
+	}
+	if sealed.DNISE164, err = svc.enc.Encrypt(ctx, input.DNISE164); err != nil {
+		return err
+	}
+	sealed.DNISHash = sql.NullString{String: svc.enc.BlindIndex(input.DNISE164), Valid: true}
+	return nil
 }
 
 // Get fetches a single call from the db
@@ -87,10 +241,12 @@ func (svc *callService) get(ctx context.Context, useTx bool, ID int64) (*Call, e
 		stmt = svc.stmts["get-call"]
 	}
 
-	p := Call{}
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
 
-	err = stmt.QueryRowContext(ctx, ID).
-		Scan(&p.ID, &p.SID, &p.ConversationID, &p.ANI, &p.DNIS, &p.Status)
+	p, err := svc.scan(ctx, stmt.QueryRowContext(ctx, ID, tenant.ID))
 	if err != nil {
 
 		if errors.Is(err, sql.ErrNoRows) {
@@ -100,7 +256,100 @@ func (svc *callService) get(ctx context.Context, useTx bool, ID int64) (*Call, e
 		return nil, errors.Wrap(err, errMsg())
 	}
 
-	return &p, nil
+	return p, nil
+}
+
+// ListByANI fetches the most recent calls from a caller, matching on the E.164 form of their number
+func (svc *callService) ListByANI(ctx context.Context, aniE164 string, limit int) ([]*Call, error) {
+	return svc.listByANI(ctx, false, aniE164, limit)
+}
+
+// ListByANITx fetches the most recent calls from a caller inside of a tx from ctx
+func (svc *callService) ListByANITx(ctx context.Context, aniE164 string, limit int) ([]*Call, error) {
+	return svc.listByANI(ctx, true, aniE164, limit)
+}
+
+// listByANI fetches the most recent calls whose normalized ANI matches
+func (svc *callService) listByANI(ctx context.Context, useTx bool, aniE164 string, limit int) ([]*Call, error) {
+	errMsg := func() string { return "Error executing list calls by ANI - " + redact.Field(redact.ANIE164, aniE164) }
+
+	var (
+		stmt *sql.Stmt
+		err  error
+		tx   *sql.Tx
+	)
+
+	if useTx {
+
+		if tx, err = FromCtx(ctx); err != nil {
+			return nil, err
+		}
+
+		stmt = tx.Stmt(svc.stmts["list-calls-by-ani"])
+	} else {
+		stmt = svc.stmts["list-calls-by-ani"]
+	}
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	rows, err := stmt.QueryContext(ctx, tenant.ID, svc.enc.BlindIndex(aniE164), limit)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+	defer rows.Close()
+
+	calls := []*Call{}
+	for rows.Next() {
+		p, err := svc.scan(ctx, rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errMsg())
+		}
+		calls = append(calls, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return calls, nil
+}
+
+// CallerHistory summarizes the calls from a caller and the outbound calls placed to them
+type CallerHistory struct {
+	Calls int64
+	// LastCallID and LastCallAt identify the caller's most recent call, 0 and zero when there is none
+	LastCallID int64
+	LastCallAt time.Time
+}
+
+// CallerHistory counts the calls from a caller, and the outbound calls placed to them, and finds
+// their most recent, matching on the E.164 form of their number. The call exclude, when not 0,
+// is left out so that a call already stored is not its own history.
+func (svc *callService) CallerHistory(ctx context.Context, aniE164 string, exclude int64) (*CallerHistory, error) {
+	errMsg := func() string { return "Error executing get caller history - " + redact.Field(redact.ANIE164, aniE164) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	var (
+		h          = CallerHistory{}
+		lastCallID sql.NullInt64
+		lastCallAt sql.NullTime
+	)
+	hash := svc.enc.BlindIndex(aniE164)
+	err = svc.stmts["get-caller-history"].QueryRowContext(ctx, tenant.ID, hash, hash, exclude, tenant.ID, hash, hash, exclude).
+		Scan(&h.Calls, &lastCallID, &lastCallAt)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+	h.LastCallID, h.LastCallAt = lastCallID.Int64, lastCallAt.Time
+
+	return &h, nil
 }
 
 // Create a new call
@@ -135,7 +384,20 @@ func (svc *callService) create(ctx context.Context, useTx bool, input *Call) err
 		stmt = svc.stmts["create-call"]
 	}
 
-	result, err := stmt.ExecContext(ctx, input.ID, input.SID, input.ConversationID, input.ANI, input.DNIS, input.Status)
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+	input.TenantID = tenant.ID
+
+	sealed, err := svc.seal(ctx, input)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	result, err := stmt.ExecContext(ctx, input.ID, input.TenantID, input.SID, nullInt64(input.ConversationID),
+		sealed.ANI, sealed.DNIS, nullString(sealed.ANIE164), nullString(sealed.DNISE164), input.ANIInvalid, input.DNISInvalid,
+		input.Direction, nullInt64(input.BlockID), nullString(input.BlockAction), sealed.ANIHash, sealed.DNISHash, input.Status, sealed.KeyID)
 	if err != nil {
 		return errors.Wrap(err, errMsg())
 	}
@@ -184,7 +446,25 @@ func (svc *callService) update(ctx context.Context, useTx bool, input *Call) err
 		stmt = svc.stmts["update-call"]
 	}
 
-	result, err := stmt.ExecContext(ctx, input.ID, input.SID, input.ConversationID, input.ANI, input.DNIS, input.Status)
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+	input.TenantID = tenant.ID
+
+	sealed, err := svc.seal(ctx, input)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+	// updates leave the direction alone, so the statement picks the DNIS columns to write
+	// from the stored one
+	if err = svc.sealDNIS(ctx, input, sealed); err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	result, err := stmt.ExecContext(ctx, input.SID, nullInt64(input.ConversationID), sealed.ANI, sealed.DNIS, input.DNIS,
+		nullString(sealed.ANIE164), nullString(sealed.DNISE164), nullString(input.DNISE164), input.ANIInvalid, input.DNISInvalid,
+		sealed.ANIHash, sealed.DNISHash, input.Status, sealed.KeyID, input.ID, input.TenantID)
 	if err != nil {
 		return errors.Wrap(err, errMsg())
 	}
@@ -233,7 +513,66 @@ func (svc *callService) delete(ctx context.Context, useTx bool, ID int64) error
 		stmt = svc.stmts["delete-call"]
 	}
 
-	result, err := stmt.ExecContext(ctx, ID)
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	result, err := stmt.ExecContext(ctx, ID, tenant.ID)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	rowCount, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	if rowCount == 0 {
+		return errors.Wrap(ErrNoRowsAffected, errMsg())
+	}
+
+	return nil
+}
+
+// SetLegalHold places or lifts a legal hold on a single call, exempting it from retention purges
+func (svc *callService) SetLegalHold(ctx context.Context, ID int64, hold bool, reason string) error {
+	return svc.setLegalHold(ctx, false, ID, hold, reason)
+}
+
+// SetLegalHoldTx places or lifts a legal hold on a single call within a tx from ctx
+func (svc *callService) SetLegalHoldTx(ctx context.Context, ID int64, hold bool, reason string) error {
+	return svc.setLegalHold(ctx, true, ID, hold, reason)
+}
+
+// setLegalHold places or lifts a legal hold. if useTx = true then it will attempt to update the call within a transaction
+// from context.
+func (svc *callService) setLegalHold(ctx context.Context, useTx bool, ID int64, hold bool, reason string) error {
+	errMsg := func() string { return "Error executing set legal hold - " + fmt.Sprint(ID) }
+
+	var (
+		stmt *sql.Stmt
+		err  error
+		tx   *sql.Tx
+	)
+
+	if useTx {
+
+		if tx, err = FromCtx(ctx); err != nil {
+			return err
+		}
+
+		stmt = tx.Stmt(svc.stmts["set-legal-hold"])
+	} else {
+		stmt = svc.stmts["set-legal-hold"]
+	}
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	result, err := stmt.ExecContext(ctx, hold, nullString(reason), ID, tenant.ID)
 	if err != nil {
 		return errors.Wrap(err, errMsg())
 	}
@@ -249,3 +588,184 @@ func (svc *callService) delete(ctx context.Context, useTx bool, ID int64) error
 
 	return nil
 }
+
+// SetOutcome records how a single call ended
+func (svc *callService) SetOutcome(ctx context.Context, ID int64, outcome string) error {
+	return svc.setOutcome(ctx, false, ID, outcome)
+}
+
+// SetOutcomeTx records how a single call ended within a tx from ctx
+func (svc *callService) SetOutcomeTx(ctx context.Context, ID int64, outcome string) error {
+	return svc.setOutcome(ctx, true, ID, outcome)
+}
+
+// setOutcome records a call's outcome. if useTx = true then it will attempt to update the call within a transaction
+// from context.
+func (svc *callService) setOutcome(ctx context.Context, useTx bool, ID int64, outcome string) error {
+	errMsg := func() string { return "Error executing set call outcome - " + fmt.Sprint(ID) }
+
+	var (
+		stmt *sql.Stmt
+		err  error
+		tx   *sql.Tx
+	)
+
+	if useTx {
+
+		if tx, err = FromCtx(ctx); err != nil {
+			return err
+		}
+
+		stmt = tx.Stmt(svc.stmts["set-call-outcome"])
+	} else {
+		stmt = svc.stmts["set-call-outcome"]
+	}
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	result, err := stmt.ExecContext(ctx, nullString(outcome), ID, tenant.ID)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	rowCount, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	if rowCount == 0 {
+		return errors.Wrap(ErrNoRowsAffected, errMsg())
+	}
+
+	return nil
+}
+
+// List fetches the most recent calls created within the filter's time range, matching the
+// filter's status, dialed number and outcome when they are set
+func (svc *callService) List(ctx context.Context, filter CallFilter, limit int) ([]*Call, error) {
+	errMsg := func() string { return "Error executing list calls - " + fmt.Sprint(filter) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	rows, err := svc.stmts["list-calls"].QueryContext(ctx, tenant.ID, filter.From.UTC(), filter.To.UTC(),
+		filter.Status, filter.Status, filter.DNISE164, filter.DNISE164, svc.enc.BlindIndex(filter.DNISE164),
+		filter.Outcome, filter.Outcome, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+	defer rows.Close()
+
+	calls := []*Call{}
+	for rows.Next() {
+		p, err := svc.scan(ctx, rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errMsg())
+		}
+		calls = append(calls, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return calls, nil
+}
+
+// StaleCall is a call that never received a terminal event
+type StaleCall struct {
+	ID int64
+	// LastEventAt is the timestamp of the call's last event in unix seconds
+	LastEventAt int64
+}
+
+// ListStale fetches up to limit calls created between from and to that have events but
+// none of terminalType, oldest first, skipping the first offset of them
+func (svc *callService) ListStale(ctx context.Context, from, to time.Time, terminalType string, offset, limit int) ([]StaleCall, error) {
+	errMsg := func() string { return "Error executing list stale calls - " + to.UTC().Format(time.RFC3339) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	rows, err := svc.stmts["list-stale-calls"].QueryContext(ctx, tenant.ID, from.UTC(), to.UTC(), terminalType, limit, offset)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+	defer rows.Close()
+
+	calls := []StaleCall{}
+	for rows.Next() {
+		var c StaleCall
+		if err = rows.Scan(&c.ID, &c.LastEventAt); err != nil {
+			return nil, errors.Wrap(err, errMsg())
+		}
+		calls = append(calls, c)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return calls, nil
+}
+
+// CountOpen counts the calls created in [from, to) that have an event of startType but none
+// of terminalType
+func (svc *callService) CountOpen(ctx context.Context, from, to time.Time, startType, terminalType string) (int64, error) {
+	errMsg := func() string { return "Error executing count open calls - " + to.UTC().Format(time.RFC3339) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, errMsg())
+	}
+
+	var count int64
+	err = svc.stmts["count-open-calls"].QueryRowContext(ctx, tenant.ID, from.UTC(), to.UTC(), startType, terminalType).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, errMsg())
+	}
+
+	return count, nil
+}
+
+// ListDNISWithEvent fetches the normalized DNIS of the calls that had an event of eventType
+// with a timestamp in [from, to), keyed by call id. Calls whose DNIS did not normalize map to "".
+func (svc *callService) ListDNISWithEvent(ctx context.Context, eventType string, from, to int64) (map[int64]string, error) {
+	errMsg := func() string { return "Error executing list DNIS with event - " + eventType }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	rows, err := svc.stmts["list-dnis-with-event"].QueryContext(ctx, tenant.ID, eventType, from, to)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+	defer rows.Close()
+
+	dnis := map[int64]string{}
+	for rows.Next() {
+		var (
+			callID int64
+			e164   sql.NullString
+		)
+		if err = rows.Scan(&callID, &e164); err != nil {
+			return nil, errors.Wrap(err, errMsg())
+		}
+		dnis[callID] = e164.String
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return dnis, nil
+}
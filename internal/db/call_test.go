@@ -0,0 +1,241 @@
+package db
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/caring/go-packages/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCall_get(t *testing.T) {
+	stmt := map[string]string{
+		"get-call": "SELECT calls",
+	}
+	columns := []string{"call_id", "tenant_id", "sid", "conversation_id", "ANI", "DNIS", "ANI_e164", "DNIS_e164", "ANI_invalid", "DNIS_invalid", "direction", "block_id", "block_action", "DNIS_hash", "status", "outcome", "pii_key_id", "created_at"}
+
+	// ensures that the tenant in context scopes the lookup
+	t.Run("With a tenant in context", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectQuery("SELECT calls").
+			WithArgs(int64(1000), "tenant-a").
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(int64(1000), "tenant-a", int64(1), int64(2), "5125551234", "8005550100", "+15125551234", "+18005550100", false, false, "inbound", nil, nil, nil, "active", "answered", nil, time.Now()))
+
+		ctx := TenantToCtx(context.Background(), &Tenant{ID: "tenant-a"})
+		call, err := store.Calls.Get(ctx, int64(1000))
+		assert.NoError(t, err, "Expecting no query error")
+		assert.Equal(t, "tenant-a", call.TenantID, "Expected tenants to match")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+
+	// ensures that a call belonging to another tenant is not found
+	t.Run("With another tenant in context", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectQuery("SELECT calls").
+			WithArgs(int64(1000), "tenant-b").
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		ctx := TenantToCtx(context.Background(), &Tenant{ID: "tenant-b"})
+		_, err = store.Calls.Get(ctx, int64(1000))
+		assert.True(t, errors.Is(err, ErrNotFound), "Expecting a not found error")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+
+	// ensures that no query is run without a tenant
+	t.Run("Without a tenant in context", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		_, err = store.Calls.Get(context.Background(), int64(1000))
+		assert.True(t, errors.Is(err, ErrNoTenant), "Expecting a missing tenant error")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+}
+
+// sealedArg matches a query argument holding an envelope that decrypts to plaintext
+type sealedArg struct {
+	plaintext string
+}
+
+func (a sealedArg) Match(v driver.Value) bool {
+	s, ok := v.(string)
+	if !ok {
+		return false
+	}
+	enc, err := NewTestEncrypter()
+	if err != nil {
+		return false
+	}
+	opened, err := enc.Decrypt(context.Background(), s)
+	return err == nil && opened == a.plaintext
+}
+
+func TestCall_create(t *testing.T) {
+	stmt := map[string]string{
+		"create-call": "INSERT calls",
+	}
+	enc, err := NewTestEncrypter()
+	if ok := assert.NoError(t, err, "Expected no error"); !ok {
+		assert.FailNow(t, "test setup failed")
+	}
+	input := &Call{
+		ID:          int64(1000),
+		SID:         int64(1),
+		ANI:         "(512) 555-1234",
+		DNIS:        "8005550100",
+		ANIE164:     "+15125551234",
+		DNISE164:    "+18005550100",
+		Direction:   "inbound",
+		BlockID:     int64(3),
+		BlockAction: "flag",
+		Status:      "active",
+	}
+
+	// ensures that ANI is written encrypted alongside its blind index
+	t.Run("Encrypts PII columns", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectExec("INSERT calls").
+			WithArgs(int64(1000), "tenant-a", int64(1), nil,
+				sealedArg{"(512) 555-1234"}, "8005550100", sealedArg{"+15125551234"}, "+18005550100", false, false,
+				"inbound", int64(3), "flag", enc.BlindIndex("+15125551234"), nil, "active", testKeyID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		ctx := TenantToCtx(context.Background(), &Tenant{ID: "tenant-a"})
+		err = store.Calls.Create(ctx, input)
+		assert.NoError(t, err, "Expecting no query error")
+		assert.Equal(t, "(512) 555-1234", input.ANI, "Expected the input to keep its plaintext")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+
+	// ensures that the party called by an outbound call is encrypted and blind indexed too
+	t.Run("Encrypts the DNIS of outbound calls", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		outbound := &Call{ID: int64(1001), ANI: "8005550100", DNIS: "(512) 555-1234", ANIE164: "+18005550100", DNISE164: "+15125551234", Direction: "outbound", Status: "active"}
+		mock.ExpectExec("INSERT calls").
+			WithArgs(int64(1001), "tenant-a", int64(0), nil,
+				sealedArg{"8005550100"}, sealedArg{"(512) 555-1234"}, sealedArg{"+18005550100"}, sealedArg{"+15125551234"}, false, false,
+				"outbound", nil, nil, enc.BlindIndex("+18005550100"), enc.BlindIndex("+15125551234"), "active", testKeyID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		ctx := TenantToCtx(context.Background(), &Tenant{ID: "tenant-a"})
+		err = store.Calls.Create(ctx, outbound)
+		assert.NoError(t, err, "Expecting no query error")
+		assert.Equal(t, "+15125551234", outbound.DNISE164, "Expected the input to keep its plaintext")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+}
+
+func TestCall_list(t *testing.T) {
+	stmt := map[string]string{
+		"list-calls": "SELECT calls",
+	}
+	columns := []string{"call_id", "tenant_id", "sid", "conversation_id", "ANI", "DNIS", "ANI_e164", "DNIS_e164", "ANI_invalid", "DNIS_invalid", "direction", "block_id", "block_action", "DNIS_hash", "status", "outcome", "pii_key_id", "created_at"}
+	from, to := time.Unix(100, 0), time.Unix(200, 0)
+	enc, err := NewTestEncrypter()
+	if ok := assert.NoError(t, err, "Expected no error"); !ok {
+		assert.FailNow(t, "test setup failed")
+	}
+
+	// ensures that the filters are passed through and the outcome read back
+	t.Run("Filters", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectQuery("SELECT calls").
+			WithArgs("tenant-a", from.UTC(), to.UTC(), "completed", "completed", "+18005550100", "+18005550100", enc.BlindIndex("+18005550100"), "short_abandon", "short_abandon", 50).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(int64(1000), "tenant-a", nil, nil, nil, "8005550100", nil, "+18005550100", false, false, "inbound", int64(3), "flag", nil, "completed", "short_abandon", nil, time.Unix(150, 0)))
+
+		ctx := TenantToCtx(context.Background(), &Tenant{ID: "tenant-a"})
+		calls, err := store.Calls.List(ctx, CallFilter{From: from, To: to, Status: "completed", DNISE164: "+18005550100", Outcome: "short_abandon"}, 50)
+		assert.NoError(t, err, "Expecting no query error")
+		if assert.Len(t, calls, 1, "Expected a single call") {
+			assert.Equal(t, "short_abandon", calls[0].Outcome, "Expected the outcome to be read")
+			assert.Equal(t, "flag", calls[0].BlockAction, "Expected the block that flagged the call to be read")
+		}
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+}
+
+func TestCall_callerHistory(t *testing.T) {
+	stmt := map[string]string{
+		"get-caller-history": "SELECT calls",
+	}
+	columns := []string{"calls", "call_id", "created_at"}
+
+	// ensures that the count and most recent call are read back
+	t.Run("With earlier calls", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectQuery("SELECT calls").
+			WithArgs("tenant-a", sqlmock.AnyArg(), sqlmock.AnyArg(), int64(1001), "tenant-a", sqlmock.AnyArg(), sqlmock.AnyArg(), int64(1001)).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(int64(3), int64(1000), time.Unix(150, 0)))
+
+		ctx := TenantToCtx(context.Background(), &Tenant{ID: "tenant-a"})
+		h, err := store.Calls.CallerHistory(ctx, "+15125551234", int64(1001))
+		assert.NoError(t, err, "Expecting no query error")
+		assert.Equal(t, &CallerHistory{Calls: 3, LastCallID: 1000, LastCallAt: time.Unix(150, 0)}, h, "Expected the history to be read")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+
+	// ensures that a first time caller has an empty history
+	t.Run("Without earlier calls", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectQuery("SELECT calls").
+			WithArgs("tenant-a", sqlmock.AnyArg(), sqlmock.AnyArg(), int64(1001), "tenant-a", sqlmock.AnyArg(), sqlmock.AnyArg(), int64(1001)).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(int64(0), nil, nil))
+
+		ctx := TenantToCtx(context.Background(), &Tenant{ID: "tenant-a"})
+		h, err := store.Calls.CallerHistory(ctx, "+15125551234", int64(1001))
+		assert.NoError(t, err, "Expecting no query error")
+		assert.Equal(t, &CallerHistory{}, h, "Expected an empty history")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+}
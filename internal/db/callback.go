@@ -0,0 +1,262 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/caring/go-packages/pkg/errors"
+
+	"github.com/caring/call-handling/internal/fieldcrypt"
+	"github.com/caring/call-handling/internal/redact"
+	"github.com/caring/call-handling/pb"
+)
+
+// callbackService provides an API for interacting with the callbacks table
+type callbackService struct {
+	db    *sql.DB
+	stmts map[string]*sql.Stmt
+	enc   *fieldcrypt.Encrypter
+	calls *callService
+}
+
+// Callback is a struct representation of a row in the callbacks table, joined with the
+// caller of its call. Times other than CreatedAt are unix seconds and are 0 when unset.
+type Callback struct {
+	ID       int64
+	TenantID string
+	CallID   int64
+	Reason   string
+	Status   string
+	DueAt    int64
+	// ClaimedBy is the identity of the agent the callback is assigned to
+	ClaimedBy   int64
+	ClaimedAt   int64
+	CompletedAt int64
+	// OutboundCallID is the call placed to the caller when the callback was completed
+	OutboundCallID int64
+	ANI            string
+	ANIE164        string
+	DNIS           string
+	CreatedAt      time.Time
+}
+
+// ToProto casts a db callback into a proto response object
+func (m *Callback) ToProto() *pb.Callback {
+	return &pb.Callback{
+		CallbackId:     m.ID,
+		CallId:         m.CallID,
+		Reason:         m.Reason,
+		Status:         m.Status,
+		DueAt:          m.DueAt,
+		ClaimedBy:      m.ClaimedBy,
+		ClaimedAt:      m.ClaimedAt,
+		CompletedAt:    m.CompletedAt,
+		OutboundCallId: m.OutboundCallID,
+		ANI:            m.ANI,
+		ANIE164:        m.ANIE164,
+		DNIS:           m.DNIS,
+	}
+}
+
+// String formats a callback for logs and error messages, masking fields the redaction policy marks sensitive
+func (m *Callback) String() string {
+	return fmt.Sprintf("&{%d, %s, %d, %s, %s, %d, %s, %s, %s}",
+		m.ID, m.TenantID, m.CallID, m.Reason, m.Status, m.DueAt,
+		redact.Field(redact.ANI, m.ANI), redact.Field(redact.ANIE164, m.ANIE164), redact.Field(redact.DNIS, m.DNIS))
+}
+
+// GoString keeps %#v from bypassing String
+func (m *Callback) GoString() string {
+	return m.String()
+}
+
+// Create schedules a callback for the caller of a call. created is false when the call has
+// no caller to call back, already has a callback, or its caller already has an open one.
+func (svc *callbackService) Create(ctx context.Context, callID int64, reason string, dueAt int64) (created bool, err error) {
+	errMsg := func() string { return "Error executing create callback - " + fmt.Sprint(callID) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, errMsg())
+	}
+
+	result, err := svc.stmts["create-callback"].ExecContext(ctx, reason, dueAt, callID, tenant.ID)
+	if err != nil {
+		return false, errors.Wrap(err, errMsg())
+	}
+
+	rowCount, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, errMsg())
+	}
+
+	return rowCount > 0, nil
+}
+
+// Get fetches a single callback
+func (svc *callbackService) Get(ctx context.Context, ID int64) (*Callback, error) {
+	errMsg := func() string { return "Error executing get callback - " + fmt.Sprint(ID) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	p, err := svc.scan(ctx, svc.stmts["get-callback"].QueryRowContext(ctx, ID, tenant.ID))
+	if err != nil {
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrap(ErrNotFound, errMsg())
+		}
+
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return p, nil
+}
+
+// List fetches up to limit callbacks in a status that are due before dueBefore, soonest first
+func (svc *callbackService) List(ctx context.Context, status string, dueBefore int64, limit int) ([]*Callback, error) {
+	errMsg := func() string { return "Error executing list callbacks - " + status }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	rows, err := svc.stmts["list-callbacks"].QueryContext(ctx, tenant.ID, status, dueBefore, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+	defer rows.Close()
+
+	callbacks := []*Callback{}
+	for rows.Next() {
+		p, err := svc.scan(ctx, rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errMsg())
+		}
+		callbacks = append(callbacks, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return callbacks, nil
+}
+
+// Claim assigns a pending callback to an agent, returns ErrNoRowsAffected if it is not pending
+func (svc *callbackService) Claim(ctx context.Context, ID, identityID, claimedAt int64) error {
+	errMsg := func() string { return "Error executing claim callback - " + fmt.Sprint(ID) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	result, err := svc.stmts["claim-callback"].ExecContext(ctx, identityID, claimedAt, ID, tenant.ID)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	rowCount, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	if rowCount == 0 {
+		return errors.Wrap(ErrNoRowsAffected, errMsg())
+	}
+
+	return nil
+}
+
+// Complete creates the outbound call placed to the caller and completes the callback in a
+// single transaction. It returns ErrNoRowsAffected if the callback is not claimed by identityID.
+func (svc *callbackService) Complete(ctx context.Context, ID, identityID, completedAt int64, outbound *Call) error {
+	errMsg := func() string { return "Error executing complete callback - " + fmt.Sprint(ID) }
+
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	if err = svc.complete(ToCtx(ctx, tx), ID, identityID, completedAt, outbound); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	return nil
+}
+
+// CompleteTx completes a callback within a tx from ctx
+func (svc *callbackService) CompleteTx(ctx context.Context, ID, identityID, completedAt int64, outbound *Call) error {
+	return svc.complete(ctx, ID, identityID, completedAt, outbound)
+}
+
+// complete completes a callback within the tx from ctx
+func (svc *callbackService) complete(ctx context.Context, ID, identityID, completedAt int64, outbound *Call) error {
+	errMsg := func() string { return "Error executing complete callback - " + fmt.Sprint(ID) }
+
+	tx, err := FromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	result, err := tx.Stmt(svc.stmts["complete-callback"]).ExecContext(ctx, completedAt, outbound.ID, ID, tenant.ID, identityID)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	rowCount, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	if rowCount == 0 {
+		return errors.Wrap(ErrNoRowsAffected, errMsg())
+	}
+
+	return svc.calls.CreateTx(ctx, outbound)
+}
+
+// scan reads a callbacks row selected in the column order used by the callback statements,
+// decrypting the caller of its call
+func (svc *callbackService) scan(ctx context.Context, row rowScanner) (*Callback, error) {
+	var (
+		p                           = Callback{}
+		claimedBy, claimedAt        sql.NullInt64
+		completedAt, outboundCallID sql.NullInt64
+		ani, aniE164, dnis, keyID   sql.NullString
+	)
+
+	err := row.Scan(&p.ID, &p.TenantID, &p.CallID, &p.Reason, &p.Status, &p.DueAt, &claimedBy, &claimedAt,
+		&completedAt, &outboundCallID, &p.CreatedAt, &ani, &aniE164, &dnis, &keyID)
+	if err != nil {
+		return nil, err
+	}
+	p.ClaimedBy, p.ClaimedAt = claimedBy.Int64, claimedAt.Int64
+	p.CompletedAt, p.OutboundCallID = completedAt.Int64, outboundCallID.Int64
+	p.DNIS = dnis.String
+
+	if p.ANI, err = openPII(ctx, svc.enc, keyID, ani.String); err != nil {
+		return nil, err
+	}
+	if p.ANIE164, err = openPII(ctx, svc.enc, keyID, aniE164.String); err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
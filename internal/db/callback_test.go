@@ -0,0 +1,63 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/caring/go-packages/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallback_complete(t *testing.T) {
+	stmt := map[string]string{
+		"complete-callback": "UPDATE callbacks",
+		"create-call":       "INSERT calls",
+	}
+	ctx := TenantToCtx(context.Background(), &Tenant{ID: "tenant-a"})
+	outbound := &Call{ID: 2000, ConversationID: 1000, ANI: "8005550100", DNIS: "5125551234", Direction: "outbound", Status: "callback"}
+
+	// ensures that the outbound call is created with the callback in one transaction
+	t.Run("Claimed by the agent", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE callbacks").
+			WithArgs(int64(500), int64(2000), int64(10), "tenant-a", int64(7)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT calls").
+			WithArgs(int64(2000), "tenant-a", int64(0), int64(1000),
+				sealedArg{"8005550100"}, sealedArg{"5125551234"}, nil, nil, false, false, "outbound", nil, nil, sqlmock.AnyArg(), "", "callback", testKeyID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err = store.Callbacks.Complete(ctx, 10, 7, 500, outbound)
+		assert.NoError(t, err, "Expecting no query error")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+
+	// ensures that nothing is created when the callback is not claimed by the agent
+	t.Run("Not claimed by the agent", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE callbacks").
+			WithArgs(int64(500), int64(2000), int64(10), "tenant-a", int64(8)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		err = store.Callbacks.Complete(ctx, 10, 8, 500, outbound)
+		assert.True(t, errors.Is(err, ErrNoRowsAffected), "Expecting a no rows affected error")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+}
@@ -0,0 +1,152 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+// cdrService provides an API for interacting with the cdrs table
+type cdrService struct {
+	db    *sql.DB
+	stmts map[string]*sql.Stmt
+}
+
+// CDR is a struct representation of a row in the cdrs table, the finalized record
+// of a completed call. Timestamps are unix seconds and are 0 when the phase was not observed.
+// DNIS is empty on outbound calls, where DNISHash identifies the party called instead.
+type CDR struct {
+	CallID          int64
+	TenantID        string
+	ConversationID  int64
+	DNIS            string
+	DNISE164        string
+	ANIHash         string
+	DNISHash        string
+	Parties         []int64
+	StartedAt       int64
+	EnqueuedAt      int64
+	RingingAt       int64
+	ConnectedAt     int64
+	DisconnectedAt  int64
+	DispositionedAt int64
+	QueueSeconds    int64
+	RingSeconds     int64
+	TalkSeconds     int64
+	DurationSeconds int64
+	Queue           string
+	Disposition     string
+	DisconnectCause string
+	CreatedAt       time.Time
+}
+
+// Create stores a CDR unless its call already has one. CDRs are immutable, so created
+// is false and the stored record is left untouched when the call was already finalized.
+// The caller's blind index is taken from the call and set on input, so the record never
+// holds their number. On outbound calls the same goes for the party called: the DNIS is
+// cleared from input and its blind index set instead.
+func (svc *cdrService) Create(ctx context.Context, input *CDR) (created bool, err error) {
+	errMsg := func() string { return "Error executing create cdr - " + fmt.Sprint(input.CallID) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, errMsg())
+	}
+	input.TenantID = tenant.ID
+
+	parties, err := json.Marshal(input.Parties)
+	if err != nil {
+		return false, errors.Wrap(err, errMsg())
+	}
+
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, errors.Wrap(err, errMsg())
+	}
+
+	var (
+		aniHash, dnisHash sql.NullString
+		direction         string
+	)
+	err = tx.Stmt(svc.stmts["get-call-hashes"]).QueryRowContext(ctx, input.CallID, input.TenantID).Scan(&aniHash, &dnisHash, &direction)
+	if err != nil {
+		tx.Rollback()
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, errors.Wrap(ErrNotFound, errMsg())
+		}
+
+		return false, errors.Wrap(err, errMsg())
+	}
+	input.ANIHash = aniHash.String
+	if direction == outbound {
+		input.DNIS, input.DNISE164, input.DNISHash = "", "", dnisHash.String
+	}
+
+	_, err = tx.Stmt(svc.stmts["create-cdr"]).ExecContext(ctx,
+		input.CallID, input.TenantID, nullInt64(input.ConversationID), nullString(input.DNIS), nullString(input.DNISE164),
+		nullString(input.ANIHash), nullString(input.DNISHash), string(parties),
+		input.StartedAt, input.EnqueuedAt, input.RingingAt, input.ConnectedAt, input.DisconnectedAt, input.DispositionedAt,
+		input.QueueSeconds, input.RingSeconds, input.TalkSeconds, input.DurationSeconds,
+		nullString(input.Queue), nullString(input.Disposition), nullString(input.DisconnectCause))
+	if err != nil {
+		tx.Rollback()
+
+		if isDuplicateKey(err) {
+			return false, nil
+		}
+
+		return false, errors.Wrap(err, errMsg())
+	}
+
+	if err = tx.Commit(); err != nil {
+		return false, errors.Wrap(err, errMsg())
+	}
+
+	return true, nil
+}
+
+// Get fetches the CDR of a single call
+func (svc *cdrService) Get(ctx context.Context, callID int64) (*CDR, error) {
+	errMsg := func() string { return "Error executing get cdr - " + fmt.Sprint(callID) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	var (
+		p                                 = CDR{}
+		dnis, dnisE164, aniHash, dnisHash sql.NullString
+		queue, disposition, cause         sql.NullString
+		conversationID                    sql.NullInt64
+		parties                           []byte
+	)
+
+	err = svc.stmts["get-cdr"].QueryRowContext(ctx, callID, tenant.ID).
+		Scan(&p.CallID, &p.TenantID, &conversationID, &dnis, &dnisE164, &aniHash, &dnisHash, &parties,
+			&p.StartedAt, &p.EnqueuedAt, &p.RingingAt, &p.ConnectedAt, &p.DisconnectedAt, &p.DispositionedAt,
+			&p.QueueSeconds, &p.RingSeconds, &p.TalkSeconds, &p.DurationSeconds, &queue, &disposition, &cause, &p.CreatedAt)
+	if err != nil {
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrap(ErrNotFound, errMsg())
+		}
+
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	if err = json.Unmarshal(parties, &p.Parties); err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	p.ConversationID = conversationID.Int64
+	p.DNIS, p.DNISE164, p.ANIHash, p.DNISHash = dnis.String, dnisE164.String, aniHash.String, dnisHash.String
+	p.Queue, p.Disposition, p.DisconnectCause = queue.String, disposition.String, cause.String
+
+	return &p, nil
+}
@@ -0,0 +1,117 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCDR_create(t *testing.T) {
+	stmt := map[string]string{
+		"get-call-hashes": "SELECT ANI_hash, DNIS_hash FROM calls",
+		"create-cdr":      "INSERT INTO cdrs",
+	}
+	columns := []string{"ANI_hash", "DNIS_hash", "direction"}
+	insert := func(mock sqlmock.Sqlmock) *sqlmock.ExpectedExec {
+		return mock.ExpectExec("INSERT INTO cdrs").
+			WithArgs(int64(1000), "tenant-a", nil, nil, "+18005550100", "abc123", nil, "[7]",
+				int64(0), int64(0), int64(0), int64(0), int64(0), int64(0),
+				int64(0), int64(0), int64(0), int64(0),
+				nil, "sale", nil)
+	}
+
+	for name, tc := range map[string]struct {
+		err     error
+		created bool
+	}{
+		// ensures that the first CDR of a call is stored
+		"New record": {nil, true},
+		// ensures that an existing CDR is left untouched
+		"Existing record": {&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"}, false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			store, mock, err := NewTestDB(stmt)
+			if ok := assert.NoError(t, err, "Expected no error"); !ok {
+				assert.FailNow(t, "test setup failed")
+			}
+
+			mock.ExpectBegin()
+			mock.ExpectQuery("SELECT ANI_hash, DNIS_hash FROM calls").
+				WithArgs(int64(1000), "tenant-a").
+				WillReturnRows(sqlmock.NewRows(columns).AddRow("abc123", nil, "inbound"))
+			if tc.err != nil {
+				insert(mock).WillReturnError(tc.err)
+				mock.ExpectRollback()
+			} else {
+				insert(mock).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			}
+
+			ctx := TenantToCtx(context.Background(), &Tenant{ID: "tenant-a"})
+			input := &CDR{CallID: 1000, DNISE164: "+18005550100", Parties: []int64{7}, Disposition: "sale"}
+			created, err := store.CDRs.Create(ctx, input)
+			assert.NoError(t, err, "Expecting no query error")
+			assert.Equal(t, tc.created, created, "Expected created to reflect whether the record was new")
+			assert.Equal(t, "abc123", input.ANIHash, "Expected the caller's blind index to be set")
+
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err, "Expecting all mock conditions to be met")
+		})
+	}
+
+	// ensures that any other insert error fails the create
+	t.Run("Insert error", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT ANI_hash, DNIS_hash FROM calls").
+			WithArgs(int64(1000), "tenant-a").
+			WillReturnRows(sqlmock.NewRows(columns).AddRow("abc123", nil, "inbound"))
+		insert(mock).WillReturnError(errors.New("connection reset"))
+		mock.ExpectRollback()
+
+		ctx := TenantToCtx(context.Background(), &Tenant{ID: "tenant-a"})
+		_, err = store.CDRs.Create(ctx, &CDR{CallID: 1000, DNISE164: "+18005550100", Parties: []int64{7}, Disposition: "sale"})
+		assert.Error(t, err, "Expecting the insert error")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+
+	// ensures that the party called by an outbound call is recorded by its blind index only
+	t.Run("Outbound call", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT ANI_hash, DNIS_hash FROM calls").
+			WithArgs(int64(1000), "tenant-a").
+			WillReturnRows(sqlmock.NewRows(columns).AddRow("abc123", "def456", "outbound"))
+		mock.ExpectExec("INSERT INTO cdrs").
+			WithArgs(int64(1000), "tenant-a", nil, nil, nil, "abc123", "def456", "[7]",
+				int64(0), int64(0), int64(0), int64(0), int64(0), int64(0),
+				int64(0), int64(0), int64(0), int64(0),
+				nil, "sale", nil).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		ctx := TenantToCtx(context.Background(), &Tenant{ID: "tenant-a"})
+		input := &CDR{CallID: 1000, DNIS: "5125551234", DNISE164: "+15125551234", Parties: []int64{7}, Disposition: "sale"}
+		_, err = store.CDRs.Create(ctx, input)
+		assert.NoError(t, err, "Expecting no query error")
+		assert.Equal(t, "", input.DNISE164, "Expected the called number to be dropped")
+		assert.Equal(t, "def456", input.DNISHash, "Expected the called party's blind index to be set")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+}
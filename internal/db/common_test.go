@@ -1,9 +1,16 @@
 package db
 
 import (
+	"bytes"
+
 	"github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/caring/call-handling/internal/fieldcrypt"
 )
 
+// testKeyID is the master key id PII is encrypted under in test stores
+const testKeyID = "test-key"
+
 // NewTestDB creates a testable store instance with a mocked sql driver
 // and provides a test utility for making assertions against and setting query
 // response values.
@@ -16,8 +23,8 @@ func NewTestDB(stmts map[string]string) (*Store, sqlmock.Sqlmock, error) {
 		return nil, nil, err
 	}
 
-	for _, s := range stmts {
-		mock.ExpectPrepare(s)
+	for _, k := range stmtNames(stmts) {
+		mock.ExpectPrepare(stmts[k])
 	}
 
 	prepared, err := prepareStmts(db, stmts)
@@ -25,12 +32,39 @@ func NewTestDB(stmts map[string]string) (*Store, sqlmock.Sqlmock, error) {
 		return nil, nil, err
 	}
 
+	enc, err := NewTestEncrypter()
+	if err != nil {
+		return nil, nil, err
+	}
+
 	s := Store{
-		db:     db,
-		stmts:  prepared,
-		Calls:  &callService{db, prepared},
-		Events: &eventService{db, prepared},
+		db:            db,
+		stmts:         prepared,
+		Calls:         &callService{db, prepared, enc},
+		Events:        &eventService{db, prepared, enc},
+		Tenants:       &tenantService{db, prepared},
+		Retention:     &retentionService{db, prepared},
+		Erasures:      &erasureService{db, prepared, enc},
+		CDRs:          &cdrService{db, prepared},
+		Conversations: &conversationService{db, prepared, enc},
+		Agents:        &agentService{db, prepared},
+		Webhooks:      &webhookService{db, prepared, enc},
+		Blocks:        &blockService{db, prepared, enc},
+		Routing:       &routingService{db, prepared},
 	}
+	s.Callbacks = &callbackService{db, prepared, enc, s.Calls}
 
 	return &s, mock, nil
 }
+
+// NewTestEncrypter creates an encrypter backed by a fixed in memory keyring
+// so that PII written by test stores can be decrypted in assertions
+func NewTestEncrypter() (*fieldcrypt.Encrypter, error) {
+	ring, err := fieldcrypt.NewKeyring(testKeyID, map[string][]byte{
+		testKeyID: bytes.Repeat([]byte{1}, 32),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fieldcrypt.NewEncrypter(ring, bytes.Repeat([]byte{2}, 32))
+}
@@ -0,0 +1,172 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/caring/go-packages/pkg/errors"
+
+	"github.com/caring/call-handling/internal/fieldcrypt"
+)
+
+// conversationService provides an API for conversations, the groups of calls that
+// share a conversation_id
+type conversationService struct {
+	db    *sql.DB
+	stmts map[string]*sql.Stmt
+	enc   *fieldcrypt.Encrypter
+}
+
+// Conversation is every call sharing a conversation_id along with their merged events
+type Conversation struct {
+	ID       int64
+	TenantID string
+	// Calls are ordered by creation
+	Calls []*Call
+	// Events are the events of every call ordered by timestamp
+	Events []*Event
+}
+
+// Get fetches a conversation with all of its calls and events, returns ErrNotFound if no call belongs to it
+func (svc *conversationService) Get(ctx context.Context, ID int64) (*Conversation, error) {
+	errMsg := func() string { return "Error executing get conversation - " + fmt.Sprint(ID) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	p := Conversation{ID: ID, TenantID: tenant.ID, Calls: []*Call{}}
+	calls := &callService{svc.db, svc.stmts, svc.enc}
+
+	rows, err := svc.stmts["list-calls-by-conversation"].QueryContext(ctx, tenant.ID, ID)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		c, err := calls.scan(ctx, rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errMsg())
+		}
+		p.Calls = append(p.Calls, c)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	if len(p.Calls) == 0 {
+		return nil, errors.Wrap(ErrNotFound, errMsg())
+	}
+
+	events, err := svc.stmts["list-events-by-conversation"].QueryContext(ctx, tenant.ID, ID)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+	defer events.Close()
+
+	if p.Events, err = scanEvents(ctx, svc.enc, events); err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return &p, nil
+}
+
+// Link moves a call into a conversation, taking it out of any conversation it was in
+func (svc *conversationService) Link(ctx context.Context, ID, callID int64) error {
+	errMsg := func() string { return "Error executing link call - " + fmt.Sprint(callID) + " to " + fmt.Sprint(ID) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	return svc.exec(ctx, "link-call", errMsg, ID, callID, tenant.ID)
+}
+
+// Start puts a call that is in no conversation into a new one, allocating the next id of the
+// tenant's counter that no call uses yet. Returns ErrNoRowsAffected if the call is already in
+// a conversation.
+func (svc *conversationService) Start(ctx context.Context, callID int64) (int64, error) {
+	errMsg := func() string { return "Error executing start conversation - " + fmt.Sprint(callID) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, errMsg())
+	}
+
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, errMsg())
+	}
+
+	// the counter row stays locked until the call is updated, so concurrent starts are given
+	// ids in turn. The counter also skips ids set by clients when creating or linking calls.
+	if _, err = tx.Stmt(svc.stmts["increment-conversation-id"]).ExecContext(ctx, tenant.ID, tenant.ID); err != nil {
+		tx.Rollback()
+		return 0, errors.Wrap(err, errMsg())
+	}
+
+	var ID int64
+	if err = tx.Stmt(svc.stmts["get-conversation-id"]).QueryRowContext(ctx, tenant.ID).Scan(&ID); err != nil {
+		tx.Rollback()
+		return 0, errors.Wrap(err, errMsg())
+	}
+
+	result, err := tx.Stmt(svc.stmts["start-conversation"]).ExecContext(ctx, ID, callID, tenant.ID)
+	if err != nil {
+		tx.Rollback()
+		return 0, errors.Wrap(err, errMsg())
+	}
+
+	rowCount, err := result.RowsAffected()
+	if err != nil {
+		tx.Rollback()
+		return 0, errors.Wrap(err, errMsg())
+	}
+	if rowCount == 0 {
+		tx.Rollback()
+		return 0, errors.Wrap(ErrNoRowsAffected, errMsg())
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, errors.Wrap(err, errMsg())
+	}
+
+	return ID, nil
+}
+
+// Unlink removes a call from a conversation, returns ErrNoRowsAffected if the call is not part of it
+func (svc *conversationService) Unlink(ctx context.Context, ID, callID int64) error {
+	errMsg := func() string {
+		return "Error executing unlink call - " + fmt.Sprint(callID) + " from " + fmt.Sprint(ID)
+	}
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	return svc.exec(ctx, "unlink-call", errMsg, callID, ID, tenant.ID)
+}
+
+// exec runs a statement that must change a single call
+func (svc *conversationService) exec(ctx context.Context, stmtName string, errMsg func() string, args ...interface{}) error {
+	result, err := svc.stmts[stmtName].ExecContext(ctx, args...)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	rowCount, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	if rowCount == 0 {
+		return errors.Wrap(ErrNoRowsAffected, errMsg())
+	}
+
+	return nil
+}
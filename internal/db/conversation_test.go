@@ -0,0 +1,173 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/caring/go-packages/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConversation_get(t *testing.T) {
+	stmt := map[string]string{
+		"list-calls-by-conversation":  "SELECT calls BY conversation",
+		"list-events-by-conversation": "SELECT events BY conversation",
+	}
+	callColumns := []string{"call_id", "tenant_id", "sid", "conversation_id", "ANI", "DNIS", "ANI_e164", "DNIS_e164", "ANI_invalid", "DNIS_invalid", "direction", "block_id", "block_action", "DNIS_hash", "status", "outcome", "pii_key_id", "created_at"}
+	eventColumns := []string{"call_id", "tenant_id", "type", "identity_id", "timestamp", "meta", "pii_key_id"}
+	ctx := TenantToCtx(context.Background(), &Tenant{ID: "tenant-a"})
+
+	// ensures that every call and the merged timeline are returned
+	t.Run("With calls", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectQuery("SELECT calls BY conversation").
+			WithArgs("tenant-a", int64(500)).
+			WillReturnRows(sqlmock.NewRows(callColumns).
+				AddRow(int64(1), "tenant-a", nil, int64(500), nil, "8005550100", nil, "+18005550100", false, false, "inbound", nil, nil, nil, "completed", "answered", nil, time.Now()).
+				AddRow(int64(2), "tenant-a", nil, int64(500), nil, "8005550100", nil, "+18005550100", false, false, "inbound", nil, nil, nil, "active", nil, nil, time.Now()))
+		mock.ExpectQuery("SELECT events BY conversation").
+			WithArgs("tenant-a", int64(500)).
+			WillReturnRows(sqlmock.NewRows(eventColumns).
+				AddRow(int64(1), "tenant-a", "connected", int64(7), int64(100), nil, nil).
+				AddRow(int64(2), "tenant-a", "dialing", int64(7), int64(200), nil, nil))
+
+		c, err := store.Conversations.Get(ctx, int64(500))
+		assert.NoError(t, err, "Expecting no query error")
+		assert.Len(t, c.Calls, 2, "Expected both calls")
+		assert.Len(t, c.Events, 2, "Expected both events")
+		assert.Equal(t, "", c.Calls[0].ANI, "Expected a NULL ANI to scan as empty")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+
+	// ensures that a conversation without calls is not found
+	t.Run("Without calls", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectQuery("SELECT calls BY conversation").
+			WithArgs("tenant-a", int64(500)).
+			WillReturnRows(sqlmock.NewRows(callColumns))
+
+		_, err = store.Conversations.Get(ctx, int64(500))
+		assert.True(t, errors.Is(err, ErrNotFound), "Expecting a not found error")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+}
+
+func TestConversation_start(t *testing.T) {
+	stmt := map[string]string{
+		"increment-conversation-id": "INSERT conversation_ids INCREMENT",
+		"get-conversation-id":       "SELECT conversation_ids",
+		"start-conversation":        "UPDATE calls START conversation",
+	}
+	ctx := TenantToCtx(context.Background(), &Tenant{ID: "tenant-a"})
+
+	// ensures that the call is put into the conversation id the counter allocates
+	t.Run("Without conversation", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT conversation_ids INCREMENT").
+			WithArgs("tenant-a", "tenant-a").
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectQuery("SELECT conversation_ids").
+			WithArgs("tenant-a").
+			WillReturnRows(sqlmock.NewRows([]string{"last_id"}).AddRow(int64(501)))
+		mock.ExpectExec("UPDATE calls START conversation").
+			WithArgs(int64(501), int64(1), "tenant-a").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		ID, err := store.Conversations.Start(ctx, int64(1))
+		assert.NoError(t, err, "Expecting no query error")
+		assert.Equal(t, int64(501), ID, "Expected the allocated conversation id")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+
+	// ensures that a call already in a conversation is left alone
+	t.Run("With conversation", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT conversation_ids INCREMENT").
+			WithArgs("tenant-a", "tenant-a").
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectQuery("SELECT conversation_ids").
+			WithArgs("tenant-a").
+			WillReturnRows(sqlmock.NewRows([]string{"last_id"}).AddRow(int64(501)))
+		mock.ExpectExec("UPDATE calls START conversation").
+			WithArgs(int64(501), int64(1), "tenant-a").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		_, err = store.Conversations.Start(ctx, int64(1))
+		assert.True(t, errors.Is(err, ErrNoRowsAffected), "Expecting a no rows affected error")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+}
+
+// ensures that a call created outside a conversation can then start one when it is transferred
+func TestConversation_startCreatedCall(t *testing.T) {
+	stmt := map[string]string{
+		"create-call":               "INSERT calls",
+		"increment-conversation-id": "INSERT conversation_ids INCREMENT",
+		"get-conversation-id":       "SELECT conversation_ids",
+		"start-conversation":        "UPDATE calls START conversation",
+	}
+	ctx := TenantToCtx(context.Background(), &Tenant{ID: "tenant-a"})
+	store, mock, err := NewTestDB(stmt)
+	if ok := assert.NoError(t, err, "Expected no error"); !ok {
+		assert.FailNow(t, "test setup failed")
+	}
+
+	// the call is stored without a conversation as NULL, which is what start-conversation matches
+	assert.Contains(t, statements["start-conversation"], "conversation_id IS NULL", "Expected calls without a conversation to be started")
+	mock.ExpectExec("INSERT calls").
+		WithArgs(int64(1000), "tenant-a", int64(1), nil,
+			"", "8005550100", nil, "+18005550100", false, false,
+			"inbound", nil, nil, "", nil, "active", testKeyID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT conversation_ids INCREMENT").
+		WithArgs("tenant-a", "tenant-a").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT conversation_ids").
+		WithArgs("tenant-a").
+		WillReturnRows(sqlmock.NewRows([]string{"last_id"}).AddRow(int64(1)))
+	mock.ExpectExec("UPDATE calls START conversation").
+		WithArgs(int64(1), int64(1000), "tenant-a").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = store.Calls.Create(ctx, &Call{ID: 1000, SID: 1, DNIS: "8005550100", DNISE164: "+18005550100", Direction: "inbound", Status: "active"})
+	assert.NoError(t, err, "Expecting no query error")
+
+	ID, err := store.Conversations.Start(ctx, int64(1000))
+	assert.NoError(t, err, "Expecting no query error")
+	assert.Equal(t, int64(1), ID, "Expected the first conversation id of the tenant")
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err, "Expecting all mock conditions to be met")
+}
@@ -0,0 +1,238 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/caring/go-packages/pkg/errors"
+
+	"github.com/caring/call-handling/internal/fieldcrypt"
+	"github.com/caring/call-handling/internal/phone"
+	"github.com/caring/call-handling/internal/redact"
+)
+
+// erasureService provides an API for right-to-erasure requests
+type erasureService struct {
+	db    *sql.DB
+	stmts map[string]*sql.Stmt
+	enc   *fieldcrypt.Encrypter
+}
+
+// ErasureReceipt is a struct representation of a row in the erasure_receipts table
+type ErasureReceipt struct {
+	ID           int64
+	TenantID     string
+	ANIHash      string
+	RequestedBy  string
+	Reference    string
+	CallsErased  int64
+	EventsErased int64
+	CallsHeld    int64
+	CreatedAt    time.Time
+}
+
+// EraseCaller anonymizes every call from a caller or placed to them, the meta of their events
+// and the caller of their CDRs and callbacks in a single transaction, and records a receipt.
+// Calls are matched by blind index, or by their stored number when they were written without
+// one. Open callbacks are cancelled. Calls under legal hold are skipped and counted. Re-running an erasure finds
+// nothing left to erase but still records a receipt.
+func (svc *erasureService) EraseCaller(ctx context.Context, aniE164, requestedBy, reference string) (*ErasureReceipt, error) {
+	errMsg := func() string { return "Error executing erase caller - " + redact.Field(redact.ANIE164, aniE164) }
+
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	receipt, err := svc.eraseCaller(ToCtx(ctx, tx), aniE164, requestedBy, reference)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return receipt, nil
+}
+
+// EraseCallerTx anonymizes every call from a caller within a tx from ctx
+func (svc *erasureService) EraseCallerTx(ctx context.Context, aniE164, requestedBy, reference string) (*ErasureReceipt, error) {
+	return svc.eraseCaller(ctx, aniE164, requestedBy, reference)
+}
+
+// eraseCaller performs the erasure within the tx from ctx
+func (svc *erasureService) eraseCaller(ctx context.Context, aniE164, requestedBy, reference string) (*ErasureReceipt, error) {
+	errMsg := func() string { return "Error executing erase caller - " + redact.Field(redact.ANIE164, aniE164) }
+
+	tx, err := FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	receipt := &ErasureReceipt{
+		TenantID:    tenant.ID,
+		ANIHash:     svc.enc.BlindIndex(aniE164),
+		RequestedBy: requestedBy,
+		Reference:   reference,
+		CreatedAt:   time.Now().UTC(),
+	}
+
+	rows, err := tx.Stmt(svc.stmts["list-calls-for-erasure"]).QueryContext(ctx, tenant.ID, receipt.ANIHash, receipt.ANIHash)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	callIDs := []int64{}
+	for rows.Next() {
+		var (
+			ID   int64
+			held bool
+		)
+		if err = rows.Scan(&ID, &held); err != nil {
+			rows.Close()
+			return nil, errors.Wrap(err, errMsg())
+		}
+		if held {
+			receipt.CallsHeld++
+			continue
+		}
+		callIDs = append(callIDs, ID)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	unhashed, held, err := svc.listUnhashedCalls(ctx, tx, tenant, aniE164)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+	callIDs = append(callIDs, unhashed...)
+	receipt.CallsHeld += held
+
+	for _, ID := range callIDs {
+		result, err := tx.Stmt(svc.stmts["erase-call-event-meta"]).ExecContext(ctx, ID, tenant.ID)
+		if err != nil {
+			return nil, errors.Wrap(err, errMsg()+" call "+fmt.Sprint(ID))
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return nil, errors.Wrap(err, errMsg())
+		}
+		receipt.EventsErased += n
+
+		if _, err = tx.Stmt(svc.stmts["erase-cdr-caller"]).ExecContext(ctx, ID, tenant.ID); err != nil {
+			return nil, errors.Wrap(err, errMsg()+" call "+fmt.Sprint(ID))
+		}
+
+		if _, err = tx.Stmt(svc.stmts["erase-callback-caller"]).ExecContext(ctx, ID, tenant.ID); err != nil {
+			return nil, errors.Wrap(err, errMsg()+" call "+fmt.Sprint(ID))
+		}
+
+		if _, err = tx.Stmt(svc.stmts["erase-webhook-deliveries"]).ExecContext(ctx, ID, tenant.ID); err != nil {
+			return nil, errors.Wrap(err, errMsg()+" call "+fmt.Sprint(ID))
+		}
+
+		if _, err = tx.Stmt(svc.stmts["erase-call-ani"]).ExecContext(ctx, ID, tenant.ID); err != nil {
+			return nil, errors.Wrap(err, errMsg()+" call "+fmt.Sprint(ID))
+		}
+		receipt.CallsErased++
+	}
+
+	result, err := tx.Stmt(svc.stmts["create-erasure-receipt"]).ExecContext(ctx, receipt.TenantID, receipt.ANIHash,
+		receipt.RequestedBy, nullString(receipt.Reference), receipt.CallsErased, receipt.EventsErased, receipt.CallsHeld)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	if receipt.ID, err = result.LastInsertId(); err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return receipt, nil
+}
+
+// listUnhashedCalls finds the calls from or to a caller that have no blind index to match on,
+// normalizing their stored number in the tenant's region. It returns the calls to erase
+// and the number skipped for a legal hold.
+func (svc *erasureService) listUnhashedCalls(ctx context.Context, tx *sql.Tx, tenant *Tenant, aniE164 string) ([]int64, int64, error) {
+	rows, err := tx.Stmt(svc.stmts["list-unhashed-calls-for-erasure"]).QueryContext(ctx, tenant.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var (
+		callIDs = []int64{}
+		held    int64
+	)
+	for rows.Next() {
+		var (
+			ID             int64
+			onHold         bool
+			direction      string
+			ani, normANI   sql.NullString
+			aniHash, key   sql.NullString
+			dnis, normDNIS sql.NullString
+			dnisHash       sql.NullString
+			matched        bool
+		)
+		if err = rows.Scan(&ID, &onHold, &ani, &normANI, &aniHash, &dnis, &normDNIS, &dnisHash, &direction, &key); err != nil {
+			return nil, 0, err
+		}
+
+		if aniHash.String == "" {
+			raw, err := openPII(ctx, svc.enc, key, ani.String)
+			if err != nil {
+				return nil, 0, err
+			}
+			e164, err := openPII(ctx, svc.enc, key, normANI.String)
+			if err != nil {
+				return nil, 0, err
+			}
+			matched = storedNumber(raw, e164, tenant) == aniE164
+		}
+		if !matched && direction == outbound && dnisHash.String == "" {
+			raw, e164, err := openDNIS(ctx, svc.enc, key, dnisHash, dnis.String, normDNIS.String)
+			if err != nil {
+				return nil, 0, err
+			}
+			matched = storedNumber(raw, e164, tenant) == aniE164
+		}
+		if !matched {
+			continue
+		}
+
+		if onHold {
+			held++
+			continue
+		}
+		callIDs = append(callIDs, ID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return callIDs, held, nil
+}
+
+// storedNumber is the E.164 form of a number stored without a blind index, normalizing the
+// raw number in the tenant's region when it was stored without one. A number that does not
+// normalize cannot be the caller's and is returned empty.
+func storedNumber(raw, e164 string, tenant *Tenant) string {
+	if e164 != "" {
+		return e164
+	}
+	number, _ := phone.Normalize(raw, tenant.Config.DefaultRegion)
+	return number
+}
@@ -0,0 +1,142 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErasure_EraseCaller(t *testing.T) {
+	stmt := map[string]string{
+		"list-calls-for-erasure":          "SELECT calls FOR UPDATE",
+		"list-unhashed-calls-for-erasure": "SELECT unhashed calls FOR UPDATE",
+		"erase-call-ani":                  "UPDATE calls",
+		"erase-call-event-meta":           "UPDATE events",
+		"erase-cdr-caller":                "UPDATE cdrs",
+		"erase-callback-caller":           "UPDATE callbacks",
+		"erase-webhook-deliveries":        "DELETE webhook_deliveries",
+		"create-erasure-receipt":          "INSERT erasure_receipts",
+	}
+	enc, err := NewTestEncrypter()
+	if ok := assert.NoError(t, err, "Expected no error"); !ok {
+		assert.FailNow(t, "test setup failed")
+	}
+	hash := enc.BlindIndex("+15125551234")
+	unhashed := []string{"call_id", "legal_hold", "ANI", "ANI_e164", "ANI_hash", "DNIS", "DNIS_e164", "DNIS_hash", "direction", "pii_key_id"}
+	ctx := TenantToCtx(context.Background(), &Tenant{ID: "tenant-a"})
+
+	// ensures that matching calls are erased in one transaction, skipping held calls
+	t.Run("Erases matching calls", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT calls FOR UPDATE").
+			WithArgs("tenant-a", hash, hash).
+			WillReturnRows(sqlmock.NewRows([]string{"call_id", "legal_hold"}).
+				AddRow(int64(1), false).
+				AddRow(int64(2), true))
+		mock.ExpectQuery("SELECT unhashed calls FOR UPDATE").
+			WithArgs("tenant-a").
+			WillReturnRows(sqlmock.NewRows(unhashed))
+		mock.ExpectExec("UPDATE events").
+			WithArgs(int64(1), "tenant-a").
+			WillReturnResult(sqlmock.NewResult(0, 4))
+		mock.ExpectExec("UPDATE cdrs").
+			WithArgs(int64(1), "tenant-a").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE callbacks").
+			WithArgs(int64(1), "tenant-a").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("DELETE webhook_deliveries").
+			WithArgs(int64(1), "tenant-a").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("UPDATE calls").
+			WithArgs(int64(1), "tenant-a").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT erasure_receipts").
+			WithArgs("tenant-a", hash, "privacy@caring.com", "CCPA-1", int64(1), int64(4), int64(1)).
+			WillReturnResult(sqlmock.NewResult(10, 1))
+		mock.ExpectCommit()
+
+		receipt, err := store.Erasures.EraseCaller(ctx, "+15125551234", "privacy@caring.com", "CCPA-1")
+		assert.NoError(t, err, "Expecting no query error")
+		assert.Equal(t, int64(10), receipt.ID, "Expected the receipt id")
+		assert.Equal(t, int64(1), receipt.CallsErased, "Expected one erased call")
+		assert.Equal(t, int64(4), receipt.EventsErased, "Expected four erased events")
+		assert.Equal(t, int64(1), receipt.CallsHeld, "Expected one held call")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+
+	// ensures that re-running an erasure still records a receipt
+	t.Run("Re-run finds nothing", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT calls FOR UPDATE").
+			WithArgs("tenant-a", hash, hash).
+			WillReturnRows(sqlmock.NewRows([]string{"call_id", "legal_hold"}))
+		mock.ExpectQuery("SELECT unhashed calls FOR UPDATE").
+			WithArgs("tenant-a").
+			WillReturnRows(sqlmock.NewRows(unhashed))
+		mock.ExpectExec("INSERT erasure_receipts").
+			WithArgs("tenant-a", hash, "privacy@caring.com", "CCPA-1", int64(0), int64(0), int64(0)).
+			WillReturnResult(sqlmock.NewResult(11, 1))
+		mock.ExpectCommit()
+
+		receipt, err := store.Erasures.EraseCaller(ctx, "+15125551234", "privacy@caring.com", "CCPA-1")
+		assert.NoError(t, err, "Expecting no query error")
+		assert.Equal(t, int64(0), receipt.CallsErased, "Expected nothing erased")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+
+	// ensures that calls written without a blind index are matched by their stored number
+	t.Run("Erases calls without a blind index", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT calls FOR UPDATE").
+			WithArgs("tenant-a", hash, hash).
+			WillReturnRows(sqlmock.NewRows([]string{"call_id", "legal_hold"}))
+		mock.ExpectQuery("SELECT unhashed calls FOR UPDATE").
+			WithArgs("tenant-a").
+			WillReturnRows(sqlmock.NewRows(unhashed).
+				AddRow(int64(3), false, "(512) 555-1234", nil, nil, "8005550100", "+18005550100", nil, "inbound", nil).
+				AddRow(int64(4), false, "5125550000", "+15125550000", nil, "8005550100", "+18005550100", nil, "inbound", nil).
+				AddRow(int64(5), true, "512-555-1234", "+15125551234", nil, "8005550100", "+18005550100", nil, "inbound", nil).
+				AddRow(int64(6), false, nil, nil, "def456", "512-555-1234", nil, nil, "outbound", nil))
+		for _, ID := range []int64{3, 6} {
+			for _, stmt := range []string{"UPDATE events", "UPDATE cdrs", "UPDATE callbacks", "DELETE webhook_deliveries", "UPDATE calls"} {
+				mock.ExpectExec(stmt).
+					WithArgs(ID, "tenant-a").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+		}
+		mock.ExpectExec("INSERT erasure_receipts").
+			WithArgs("tenant-a", hash, "privacy@caring.com", "CCPA-1", int64(2), int64(2), int64(1)).
+			WillReturnResult(sqlmock.NewResult(12, 1))
+		mock.ExpectCommit()
+
+		receipt, err := store.Erasures.EraseCaller(ctx, "+15125551234", "privacy@caring.com", "CCPA-1")
+		assert.NoError(t, err, "Expecting no query error")
+		assert.Equal(t, int64(2), receipt.CallsErased, "Expected the unhashed calls from and to the caller erased")
+		assert.Equal(t, int64(1), receipt.CallsHeld, "Expected the held unhashed call counted")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+}
@@ -1,6 +1,10 @@
 package db
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/go-sql-driver/mysql"
+)
 
 var (
 	// ErrNoRows occurs when no records were found
@@ -9,4 +13,15 @@ var (
 	ErrNoRowsAffected = errors.New("no rows affected")
 	// ErrNotFound when a specific reqcord was not found
 	ErrNotFound = errors.New("the record you are attempting to update is not found")
+	// ErrNoTenant occurs when a query is attempted without a tenant in context
+	ErrNoTenant = errors.New("no tenant present in context")
 )
+
+// mysqlDuplicateKey is the MySQL error number of an insert violating a unique key
+const mysqlDuplicateKey = 1062
+
+// isDuplicateKey reports whether err is MySQL rejecting a row that violates a unique key
+func isDuplicateKey(err error) bool {
+	var e *mysql.MySQLError
+	return errors.As(err, &e) && e.Number == mysqlDuplicateKey
+}
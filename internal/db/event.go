@@ -4,7 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 
+	"github.com/caring/call-handling/internal/fieldcrypt"
+	"github.com/caring/call-handling/internal/redact"
 	"github.com/caring/call-handling/pb"
 	"github.com/caring/go-packages/pkg/errors"
 )
@@ -12,10 +15,12 @@ import (
 type eventService struct {
 	db    *sql.DB
 	stmts map[string]*sql.Stmt
+	enc   *fieldcrypt.Encrypter
 }
 
 type Event struct {
 	CallID     int64
+	TenantID   string
 	Type       string
 	IdentityID int64
 	Timestamp  int64
@@ -47,6 +52,17 @@ func (m *Event) ToProto() *pb.EventResponse {
 	}
 }
 
+// String formats an event for logs and error messages, masking fields the redaction policy marks sensitive
+func (m *Event) String() string {
+	return fmt.Sprintf("&{%d, %s, %d, %d, %s}",
+		m.CallID, m.Type, m.IdentityID, m.Timestamp, redact.Field(redact.Meta, m.Meta))
+}
+
+// GoString keeps %#v from bypassing String
+func (m *Event) GoString() string {
+	return m.String()
+}
+
 func (svc *eventService) Get(ctx context.Context, ID int64) (*Event, error) {
 	return svc.get(ctx, false, ID)
 }
@@ -75,10 +91,18 @@ func (svc *eventService) get(ctx context.Context, useTx bool, ID int64) (*Event,
 		stmt = svc.stmts["get-event"]
 	}
 
-	p := Event{}
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	var (
+		p           = Event{}
+		meta, keyID sql.NullString
+	)
 
-	err = stmt.QueryRowContext(ctx, ID).
-		Scan(&p.CallID, &p.Type, &p.IdentityID, &p.Timestamp, &p.Meta)
+	err = stmt.QueryRowContext(ctx, ID, tenant.ID).
+		Scan(&p.CallID, &p.TenantID, &p.Type, &p.IdentityID, &p.Timestamp, &meta, &keyID)
 	if err != nil {
 
 		if errors.Is(err, sql.ErrNoRows) {
@@ -88,6 +112,10 @@ func (svc *eventService) get(ctx context.Context, useTx bool, ID int64) (*Event,
 		return nil, errors.Wrap(err, errMsg())
 	}
 
+	if p.Meta, err = openPII(ctx, svc.enc, keyID, meta.String); err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
 	return &p, nil
 }
 
@@ -119,7 +147,18 @@ func (svc *eventService) create(ctx context.Context, useTx bool, input *Event) e
 		stmt = svc.stmts["create-event"]
 	}
 
-	result, err := stmt.ExecContext(ctx, input.CallID, input.Type, input.IdentityID, input.Timestamp, input.Meta)
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+	input.TenantID = tenant.ID
+
+	meta, err := svc.enc.Encrypt(ctx, input.Meta)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	result, err := stmt.ExecContext(ctx, input.CallID, input.TenantID, input.Type, input.IdentityID, input.Timestamp, meta, svc.enc.CurrentKeyID())
 	if err != nil {
 		return errors.Wrap(err, errMsg())
 	}
@@ -135,3 +174,223 @@ func (svc *eventService) create(ctx context.Context, useTx bool, input *Event) e
 
 	return nil
 }
+
+// CreateChecked stores an event once check accepts the events recorded for its call up to
+// the event's timestamp. The call is locked from the check until the event is stored, so
+// checked events of a call are stored one at a time. Returns ErrNotFound if the call does
+// not exist, and check's error as is if it rejects the event.
+func (svc *eventService) CreateChecked(ctx context.Context, input *Event, check func([]*Event) error) error {
+	errMsg := func() string { return "Error executing create checked event - " + fmt.Sprint(input) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	var callID int64
+	if err = tx.Stmt(svc.stmts["lock-call"]).QueryRowContext(ctx, input.CallID, tenant.ID).Scan(&callID); err != nil {
+		tx.Rollback()
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.Wrap(ErrNotFound, errMsg())
+		}
+		return errors.Wrap(err, errMsg())
+	}
+
+	rows, err := tx.Stmt(svc.stmts["list-events-by-call-until"]).QueryContext(ctx, input.CallID, tenant.ID, input.Timestamp)
+	if err != nil {
+		tx.Rollback()
+		return errors.Wrap(err, errMsg())
+	}
+	recorded, err := scanEvents(ctx, svc.enc, rows)
+	rows.Close()
+	if err != nil {
+		tx.Rollback()
+		return errors.Wrap(err, errMsg())
+	}
+
+	if err = check(recorded); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err = svc.create(ToCtx(ctx, tx), true, input); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	return nil
+}
+
+// ListByCall fetches every event of a call in the order they occurred
+func (svc *eventService) ListByCall(ctx context.Context, callID int64) ([]*Event, error) {
+	errMsg := func() string { return "Error executing list events by call - " + fmt.Sprint(callID) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	rows, err := svc.stmts["list-events-by-call"].QueryContext(ctx, callID, tenant.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+	defer rows.Close()
+
+	events, err := scanEvents(ctx, svc.enc, rows)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return events, nil
+}
+
+// ListCallsWithEvent fetches every event of the calls that had an event of eventType
+// with a timestamp in [from, to), ordered by call and then timestamp
+func (svc *eventService) ListCallsWithEvent(ctx context.Context, eventType string, from, to int64) ([]*Event, error) {
+	errMsg := func() string { return "Error executing list calls with event - " + eventType }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	rows, err := svc.stmts["list-calls-with-event"].QueryContext(ctx, tenant.ID, tenant.ID, eventType, from, to)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+	defer rows.Close()
+
+	events, err := scanEvents(ctx, svc.enc, rows)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return events, nil
+}
+
+// ListCallsWithIdentity fetches every event of the calls that identityID had an event on
+// with a timestamp in [from, to), ordered by call and then timestamp
+func (svc *eventService) ListCallsWithIdentity(ctx context.Context, identityID, from, to int64) ([]*Event, error) {
+	errMsg := func() string { return "Error executing list calls with identity - " + fmt.Sprint(identityID) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	rows, err := svc.stmts["list-calls-with-identity"].QueryContext(ctx, tenant.ID, tenant.ID, identityID, from, to)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+	defer rows.Close()
+
+	events, err := scanEvents(ctx, svc.enc, rows)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return events, nil
+}
+
+// scanEvents reads events rows selected in the column order used by the event statements,
+// decrypting their meta
+func scanEvents(ctx context.Context, enc *fieldcrypt.Encrypter, rows *sql.Rows) ([]*Event, error) {
+	events := []*Event{}
+	for rows.Next() {
+		var (
+			p           = Event{}
+			meta, keyID sql.NullString
+			err         error
+		)
+		if err = rows.Scan(&p.CallID, &p.TenantID, &p.Type, &p.IdentityID, &p.Timestamp, &meta, &keyID); err != nil {
+			return nil, err
+		}
+		if p.Meta, err = openPII(ctx, enc, keyID, meta.String); err != nil {
+			return nil, err
+		}
+		events = append(events, &p)
+	}
+
+	return events, rows.Err()
+}
+
+// CreateBatch inserts events with a single multi-row insert, either every event is stored or none are
+func (svc *eventService) CreateBatch(ctx context.Context, input []*Event) error {
+	return svc.createBatch(ctx, false, input)
+}
+
+// CreateBatchTx inserts events with a single multi-row insert within a tx from ctx
+func (svc *eventService) CreateBatchTx(ctx context.Context, input []*Event) error {
+	return svc.createBatch(ctx, true, input)
+}
+
+func (svc *eventService) createBatch(ctx context.Context, useTx bool, input []*Event) error {
+	errMsg := func() string { return "Error executing create events - " + fmt.Sprint(len(input)) + " events" }
+
+	if len(input) == 0 {
+		return nil
+	}
+
+	var (
+		exec interface {
+			ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+		} = svc.db
+		err error
+	)
+
+	if useTx {
+		if exec, err = FromCtx(ctx); err != nil {
+			return err
+		}
+	}
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	var (
+		query = strings.Builder{}
+		args  = make([]interface{}, 0, len(input)*7)
+	)
+	query.WriteString(createEventsPrefix)
+	for i, e := range input {
+		e.TenantID = tenant.ID
+
+		meta, err := svc.enc.Encrypt(ctx, e.Meta)
+		if err != nil {
+			return errors.Wrap(err, errMsg())
+		}
+
+		if i > 0 {
+			query.WriteString(", ")
+		}
+		query.WriteString(createEventsRow)
+		args = append(args, e.CallID, e.TenantID, e.Type, e.IdentityID, e.Timestamp, meta, svc.enc.CurrentKeyID())
+	}
+
+	result, err := exec.ExecContext(ctx, query.String(), args...)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	rowCount, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	if rowCount != int64(len(input)) {
+		return errors.Wrap(ErrNoRowsAffected, errMsg())
+	}
+
+	return nil
+}
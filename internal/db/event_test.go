@@ -7,6 +7,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/caring/call-handling/pb"
+	"github.com/caring/go-packages/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -45,12 +46,16 @@ func TestEvent_create(t *testing.T) {
 	}
 	args := []driver.Value{
 		int64(2000),
+		"tenant-a",
 		"ringing",
 		int64(9090),
 		int64(20200101),
-		"twilio_meta",
+		sqlmock.AnyArg(),
+		testKeyID,
 	}
 
+	tenantCtx := TenantToCtx(context.Background(), &Tenant{ID: "tenant-a"})
+
 	// ensures that execution within a transaction occurs without error
 	t.Run("With a provided transaction", func(t *testing.T) {
 		store, mock, err := NewTestDB(stmt)
@@ -68,7 +73,7 @@ func TestEvent_create(t *testing.T) {
 			assert.FailNow(t, "transaction setup failed")
 		}
 
-		err = store.Events.CreateTx(ToCtx(context.Background(), tx), input)
+		err = store.Events.CreateTx(ToCtx(tenantCtx, tx), input)
 		assert.NoError(t, err, "Expecting no query error")
 
 		err = mock.ExpectationsWereMet()
@@ -86,7 +91,7 @@ func TestEvent_create(t *testing.T) {
 			WithArgs(args...).
 			WillReturnResult(sqlmock.NewResult(0, 1))
 
-		err = store.Events.Create(context.Background(), input)
+		err = store.Events.Create(tenantCtx, input)
 		assert.NoError(t, err, "Expecting no query error")
 
 		err = mock.ExpectationsWereMet()
@@ -104,8 +109,144 @@ func TestEvent_create(t *testing.T) {
 			WithArgs(args...).
 			WillReturnResult(sqlmock.NewResult(0, 0))
 
-		err = store.Events.Create(context.Background(), input)
-		assert.EqualError(t, err, "Error executing create call - &{2000, ringing, 9090, 20200101, twilio_meta}: no new rows were created", "Expecting no query error")
+		err = store.Events.Create(tenantCtx, input)
+		assert.EqualError(t, err, "Error executing create event - &{2000, ringing, 9090, 20200101, [REDACTED]}: no rows affected", "Expecting a redacted error")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+}
+
+func TestEvent_createChecked(t *testing.T) {
+	stmt := map[string]string{
+		"lock-call":                 "SELECT call FOR UPDATE",
+		"list-events-by-call-until": "SELECT events UNTIL",
+		"create-event":              "INSERT events",
+	}
+	eventColumns := []string{"call_id", "tenant_id", "type", "identity_id", "timestamp", "meta", "pii_key_id"}
+	input := &Event{CallID: int64(2000), Type: "held", IdentityID: int64(9090), Timestamp: int64(300)}
+	tenantCtx := TenantToCtx(context.Background(), &Tenant{ID: "tenant-a"})
+
+	// ensures that the check sees the events up to the event's timestamp and the event is
+	// stored in the same transaction
+	t.Run("Accepted", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT call FOR UPDATE").
+			WithArgs(int64(2000), "tenant-a").
+			WillReturnRows(sqlmock.NewRows([]string{"call_id"}).AddRow(int64(2000)))
+		mock.ExpectQuery("SELECT events UNTIL").
+			WithArgs(int64(2000), "tenant-a", int64(300)).
+			WillReturnRows(sqlmock.NewRows(eventColumns).
+				AddRow(int64(2000), "tenant-a", "connected", int64(9090), int64(100), nil, nil))
+		mock.ExpectExec("INSERT events").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		var seen []*Event
+		err = store.Events.CreateChecked(tenantCtx, input, func(recorded []*Event) error {
+			seen = recorded
+			return nil
+		})
+		assert.NoError(t, err, "Expecting no query error")
+		assert.Len(t, seen, 1, "Expected the recorded events to be checked")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+
+	// ensures that a rejected event is not stored and the check's error is returned
+	t.Run("Rejected", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT call FOR UPDATE").
+			WithArgs(int64(2000), "tenant-a").
+			WillReturnRows(sqlmock.NewRows([]string{"call_id"}).AddRow(int64(2000)))
+		mock.ExpectQuery("SELECT events UNTIL").
+			WithArgs(int64(2000), "tenant-a", int64(300)).
+			WillReturnRows(sqlmock.NewRows(eventColumns))
+		mock.ExpectRollback()
+
+		rejected := errors.New("rejected")
+		err = store.Events.CreateChecked(tenantCtx, input, func([]*Event) error { return rejected })
+		assert.Equal(t, rejected, err, "Expecting the check's error")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+
+	// ensures that events of an unknown call are not found
+	t.Run("Unknown call", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT call FOR UPDATE").
+			WithArgs(int64(2000), "tenant-a").
+			WillReturnRows(sqlmock.NewRows([]string{"call_id"}))
+		mock.ExpectRollback()
+
+		err = store.Events.CreateChecked(tenantCtx, input, func([]*Event) error { return nil })
+		assert.True(t, errors.Is(err, ErrNotFound), "Expecting a not found error")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+}
+
+func TestEvent_createBatch(t *testing.T) {
+	input := []*Event{
+		{CallID: int64(2000), Type: "ringing", IdentityID: int64(9090), Timestamp: int64(20200101)},
+		{CallID: int64(2000), Type: "connected", IdentityID: int64(9090), Timestamp: int64(20200102)},
+	}
+	args := []driver.Value{
+		int64(2000), "tenant-a", "ringing", int64(9090), int64(20200101), sqlmock.AnyArg(), testKeyID,
+		int64(2000), "tenant-a", "connected", int64(9090), int64(20200102), sqlmock.AnyArg(), testKeyID,
+	}
+
+	tenantCtx := TenantToCtx(context.Background(), &Tenant{ID: "tenant-a"})
+
+	// ensures that every event is written with a single multi-row insert
+	t.Run("Without a transaction", func(t *testing.T) {
+		store, mock, err := NewTestDB(map[string]string{})
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectExec(`INSERT INTO events .* values\(\?, \?, \?, \?, \?, \?, \?\), \(\?, \?, \?, \?, \?, \?, \?\)`).
+			WithArgs(args...).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		err = store.Events.CreateBatch(tenantCtx, input)
+		assert.NoError(t, err, "Expecting no query error")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+
+	// ensures that a short insert is reported
+	t.Run("With missing rows", func(t *testing.T) {
+		store, mock, err := NewTestDB(map[string]string{})
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectExec("INSERT INTO events").
+			WithArgs(args...).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err = store.Events.CreateBatch(tenantCtx, input)
+		assert.Error(t, err, "Expecting a no rows affected error")
 
 		err = mock.ExpectationsWereMet()
 		assert.NoError(t, err, "Expecting all mock conditions to be met")
@@ -0,0 +1,117 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+// CallFilter selects calls by when they were created and what became of them
+type CallFilter struct {
+	// From and To bound the call creation time, From inclusive and To exclusive
+	From time.Time
+	To   time.Time
+	// Status matches calls.status exactly when set
+	Status string
+	// DNISE164 matches the normalized dialed number when set, by its blind index on outbound calls
+	DNISE164 string
+	// Outcome matches the classified outcome of the call when set
+	Outcome string
+}
+
+// Export streams every call of the tenant in context matching filter to fn along with its
+// events in timestamp order. Rows are read as they arrive from the db so the export is
+// never held in memory; fn returning an error stops the export.
+func (svc *callService) Export(ctx context.Context, filter CallFilter, fn func(*Call, []*Event) error) error {
+	errMsg := func() string { return "Error executing export calls - " + fmt.Sprint(filter) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	rows, err := svc.stmts["export-calls"].QueryContext(ctx, tenant.ID, filter.From.UTC(), filter.To.UTC(),
+		filter.Status, filter.Status, filter.DNISE164, filter.DNISE164, svc.enc.BlindIndex(filter.DNISE164),
+		filter.Outcome, filter.Outcome)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+	defer rows.Close()
+
+	var (
+		call   *Call
+		events []*Event
+	)
+
+	for rows.Next() {
+		var (
+			c                          = Call{}
+			sid, conversation, blockID sql.NullInt64
+			ani, dnis, status, outcome sql.NullString
+			aniE164, dnisE164, keyID   sql.NullString
+			eventID, identity, ts      sql.NullInt64
+			eventType, meta, metaKeyID sql.NullString
+			blockAction, dnisHash      sql.NullString
+		)
+
+		err = rows.Scan(&c.ID, &c.TenantID, &sid, &conversation, &ani, &dnis, &aniE164, &dnisE164, &c.ANIInvalid, &c.DNISInvalid,
+			&c.Direction, &blockID, &blockAction, &dnisHash, &status, &outcome, &keyID, &c.CreatedAt,
+			&eventID, &eventType, &identity, &ts, &meta, &metaKeyID)
+		if err != nil {
+			return errors.Wrap(err, errMsg())
+		}
+
+		if call == nil || call.ID != c.ID {
+			if call != nil {
+				if err = fn(call, events); err != nil {
+					return err
+				}
+			}
+
+			c.SID, c.ConversationID = sid.Int64, conversation.Int64
+			c.BlockID, c.BlockAction = blockID.Int64, blockAction.String
+			c.Status, c.Outcome = status.String, outcome.String
+			if c.ANI, err = openPII(ctx, svc.enc, keyID, ani.String); err != nil {
+				return errors.Wrap(err, errMsg())
+			}
+			if c.ANIE164, err = openPII(ctx, svc.enc, keyID, aniE164.String); err != nil {
+				return errors.Wrap(err, errMsg())
+			}
+			if c.DNIS, c.DNISE164, err = openDNIS(ctx, svc.enc, keyID, dnisHash, dnis.String, dnisE164.String); err != nil {
+				return errors.Wrap(err, errMsg())
+			}
+
+			call, events = &c, []*Event{}
+		}
+
+		// calls without events come back with a single row of NULL event columns
+		if !eventID.Valid {
+			continue
+		}
+
+		e := &Event{
+			CallID:     call.ID,
+			TenantID:   call.TenantID,
+			Type:       eventType.String,
+			IdentityID: identity.Int64,
+			Timestamp:  ts.Int64,
+		}
+		if e.Meta, err = openPII(ctx, svc.enc, metaKeyID, meta.String); err != nil {
+			return errors.Wrap(err, errMsg())
+		}
+		events = append(events, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	if call != nil {
+		return fn(call, events)
+	}
+
+	return nil
+}
@@ -0,0 +1,10 @@
+ALTER TABLE events
+    DROP INDEX ix__events__tenant_id,
+    DROP COLUMN tenant_id;
+
+ALTER TABLE calls
+    DROP INDEX ix__calls__tenant_id,
+    DROP COLUMN deleted_at,
+    DROP COLUMN tenant_id;
+
+DROP TABLE IF EXISTS tenants;
@@ -0,0 +1,34 @@
+CREATE TABLE tenants (
+    tenant_id   VARCHAR(64) NOT NULL PRIMARY KEY COMMENT 'Brand or telephony account that owns calls and events',
+    name        VARCHAR(128) NOT NULL,
+    config      JSON COMMENT 'Per-tenant configuration, see db.TenantConfig',
+    created_at  DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at  DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at  DATETIME
+)
+ENGINE=InnoDB
+DEFAULT CHARSET=utf8mb4
+COMMENT='CallHandling Service: Tenants sharing the telephony account';
+
+ALTER TABLE calls
+    ADD COLUMN tenant_id VARCHAR(64) NOT NULL DEFAULT '' AFTER call_id,
+    ADD COLUMN deleted_at DATETIME,
+    ADD INDEX ix__calls__tenant_id (tenant_id, call_id);
+
+ALTER TABLE events
+    ADD COLUMN tenant_id VARCHAR(64) NOT NULL DEFAULT '' AFTER call_id,
+    ADD INDEX ix__events__tenant_id (tenant_id, call_id);
+
+-- calls and events from before tenants belong to the single tenant of the account, which
+-- starts out as "default". Set its name and config, or move the rows to another tenant id,
+-- once the migration has run.
+INSERT INTO tenants (tenant_id, name)
+SELECT 'default', 'Default'
+FROM DUAL
+WHERE EXISTS (SELECT 1 FROM calls) OR EXISTS (SELECT 1 FROM events);
+
+UPDATE calls SET tenant_id = 'default' WHERE tenant_id = '';
+UPDATE events SET tenant_id = 'default' WHERE tenant_id = '';
+
+ALTER TABLE calls ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE events ALTER COLUMN tenant_id DROP DEFAULT;
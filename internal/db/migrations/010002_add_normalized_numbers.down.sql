@@ -0,0 +1,5 @@
+ALTER TABLE calls
+    DROP INDEX ix__calls__DNIS_e164,
+    DROP INDEX ix__calls__ANI_e164,
+    DROP COLUMN DNIS_e164,
+    DROP COLUMN ANI_e164;
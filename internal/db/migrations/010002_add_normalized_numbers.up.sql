@@ -0,0 +1,5 @@
+ALTER TABLE calls
+    ADD COLUMN ANI_e164  VARCHAR(16) COMMENT 'ANI normalized to E.164, NULL when the raw ANI could not be parsed' AFTER DNIS,
+    ADD COLUMN DNIS_e164 VARCHAR(16) COMMENT 'DNIS normalized to E.164, NULL when the raw DNIS could not be parsed' AFTER ANI_e164,
+    ADD INDEX ix__calls__ANI_e164 (tenant_id, ANI_e164),
+    ADD INDEX ix__calls__DNIS_e164 (tenant_id, DNIS_e164);
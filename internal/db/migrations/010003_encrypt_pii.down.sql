@@ -0,0 +1,15 @@
+-- encrypted values are not decrypted by this migration, run with all rows still in plaintext only
+ALTER TABLE events
+    DROP INDEX ix__events__pii_key_id,
+    DROP COLUMN pii_key_id,
+    MODIFY COLUMN meta VARCHAR(50) COMMENT 'Metadata provided by Twilio',
+    DROP COLUMN event_id;
+
+ALTER TABLE calls
+    DROP INDEX ix__calls__pii_key_id,
+    DROP INDEX ix__calls__ANI_hash,
+    ADD INDEX ix__calls__ANI_e164 (tenant_id, ANI_e164),
+    DROP COLUMN pii_key_id,
+    DROP COLUMN ANI_hash,
+    MODIFY COLUMN ANI_e164 VARCHAR(16) COMMENT 'ANI normalized to E.164, NULL when the raw ANI could not be parsed',
+    MODIFY COLUMN ANI      VARCHAR(50) COMMENT 'Origin number for an incoming call';
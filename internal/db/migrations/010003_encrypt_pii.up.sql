@@ -0,0 +1,14 @@
+ALTER TABLE calls
+    MODIFY COLUMN ANI      VARCHAR(512) COMMENT 'Origin number for an incoming call, encrypted under pii_key_id',
+    MODIFY COLUMN ANI_e164 VARCHAR(512) COMMENT 'ANI normalized to E.164, encrypted under pii_key_id, NULL when the raw ANI could not be parsed',
+    ADD COLUMN ANI_hash    CHAR(64) COMMENT 'Blind index (HMAC-SHA256) of the plaintext ANI_e164' AFTER ANI_e164,
+    ADD COLUMN pii_key_id  VARCHAR(256) COMMENT 'Master key PII columns are encrypted under, NULL for rows not yet encrypted',
+    DROP INDEX ix__calls__ANI_e164,
+    ADD INDEX ix__calls__ANI_hash (tenant_id, ANI_hash),
+    ADD INDEX ix__calls__pii_key_id (pii_key_id);
+
+ALTER TABLE events
+    ADD COLUMN event_id   BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY FIRST,
+    MODIFY COLUMN meta    TEXT COMMENT 'Metadata provided by Twilio, encrypted under pii_key_id',
+    ADD COLUMN pii_key_id VARCHAR(256) COMMENT 'Master key PII columns are encrypted under, NULL for rows not yet encrypted',
+    ADD INDEX ix__events__pii_key_id (pii_key_id);
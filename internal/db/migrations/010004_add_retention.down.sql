@@ -0,0 +1,10 @@
+ALTER TABLE events
+    DROP INDEX ix__events__created_at,
+    DROP COLUMN created_at;
+
+ALTER TABLE calls
+    DROP INDEX ix__calls__created_at,
+    DROP COLUMN anonymized_at,
+    DROP COLUMN legal_hold_reason,
+    DROP COLUMN legal_hold,
+    DROP COLUMN created_at;
@@ -0,0 +1,10 @@
+ALTER TABLE calls
+    ADD COLUMN created_at        DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD COLUMN legal_hold        BOOLEAN NOT NULL DEFAULT FALSE COMMENT 'Held calls are exempt from retention purges',
+    ADD COLUMN legal_hold_reason VARCHAR(255),
+    ADD COLUMN anonymized_at     DATETIME COMMENT 'When ANI was purged by retention',
+    ADD INDEX ix__calls__created_at (tenant_id, created_at);
+
+ALTER TABLE events
+    ADD COLUMN created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD INDEX ix__events__created_at (tenant_id, created_at);
@@ -0,0 +1,15 @@
+CREATE TABLE erasure_receipts (
+    receipt_id     BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    tenant_id      VARCHAR(64) NOT NULL,
+    ANI_hash       CHAR(64) NOT NULL COMMENT 'Blind index of the erased number, the number itself is never stored',
+    requested_by   VARCHAR(255) NOT NULL,
+    reference      VARCHAR(255) COMMENT 'External id of the deletion request, e.g. a CCPA ticket',
+    calls_erased   INT NOT NULL,
+    events_erased  INT NOT NULL,
+    calls_held     INT NOT NULL COMMENT 'Matching calls left untouched because of a legal hold',
+    created_at     DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX ix__erasure_receipts__ANI_hash (tenant_id, ANI_hash)
+)
+ENGINE=InnoDB
+DEFAULT CHARSET=utf8mb4
+COMMENT='CallHandling Service: Audit trail of right-to-erasure requests';
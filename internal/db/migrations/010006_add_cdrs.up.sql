@@ -0,0 +1,27 @@
+CREATE TABLE cdrs (
+    call_id           BIGINT NOT NULL PRIMARY KEY,
+    tenant_id         VARCHAR(64) NOT NULL,
+    conversation_id   BIGINT,
+    DNIS              VARCHAR(64),
+    DNIS_e164         VARCHAR(16),
+    ANI_hash          CHAR(64) COMMENT 'Blind index of the caller, the number itself is never stored',
+    parties           JSON NOT NULL COMMENT 'Identity ids of every party that connected or joined',
+    started_at        BIGINT NOT NULL,
+    enqueued_at       BIGINT NOT NULL,
+    ringing_at        BIGINT NOT NULL,
+    connected_at      BIGINT NOT NULL,
+    disconnected_at   BIGINT NOT NULL,
+    dispositioned_at  BIGINT NOT NULL,
+    queue_seconds     INT NOT NULL,
+    ring_seconds      INT NOT NULL,
+    talk_seconds      INT NOT NULL,
+    duration_seconds  INT NOT NULL,
+    queue             VARCHAR(255),
+    disposition       VARCHAR(255),
+    disconnect_cause  VARCHAR(255),
+    created_at        DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX ix__cdrs__created_at (tenant_id, created_at)
+)
+ENGINE=InnoDB
+DEFAULT CHARSET=utf8mb4
+COMMENT='CallHandling Service: Immutable call detail records written when a call completes';
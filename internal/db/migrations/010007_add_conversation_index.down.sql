@@ -0,0 +1,2 @@
+ALTER TABLE calls
+    DROP INDEX ix__calls__conversation_id;
@@ -0,0 +1,2 @@
+ALTER TABLE calls
+    ADD INDEX ix__calls__conversation_id (tenant_id, conversation_id);
@@ -0,0 +1,2 @@
+ALTER TABLE events
+    ADD INDEX ix__events__type_timestamp (tenant_id, type, timestamp);
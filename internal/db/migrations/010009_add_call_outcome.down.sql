@@ -0,0 +1,3 @@
+ALTER TABLE calls
+    DROP INDEX ix__calls__outcome_created_at,
+    DROP COLUMN outcome;
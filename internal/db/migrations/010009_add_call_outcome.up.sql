@@ -0,0 +1,3 @@
+ALTER TABLE calls
+    ADD COLUMN outcome VARCHAR(32) NULL AFTER status,
+    ADD INDEX ix__calls__outcome_created_at (tenant_id, outcome, created_at);
@@ -0,0 +1,20 @@
+CREATE TABLE callbacks (
+    callback_id       BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    tenant_id         VARCHAR(64) NOT NULL,
+    call_id           BIGINT NOT NULL COMMENT 'The call whose caller is called back, the number is read from it',
+    ANI_hash          CHAR(64) COMMENT 'Blind index of the caller, one open callback is kept per caller',
+    reason            VARCHAR(32) NOT NULL,
+    status            VARCHAR(32) NOT NULL DEFAULT 'pending',
+    due_at            BIGINT NOT NULL,
+    claimed_by        BIGINT,
+    claimed_at        BIGINT,
+    completed_at      BIGINT,
+    outbound_call_id  BIGINT,
+    created_at        DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE INDEX ux__callbacks__call_id (tenant_id, call_id),
+    INDEX ix__callbacks__status_due_at (tenant_id, status, due_at),
+    INDEX ix__callbacks__ani_hash (tenant_id, ANI_hash, status)
+)
+ENGINE=InnoDB
+DEFAULT CHARSET=utf8mb4
+COMMENT='CallHandling Service: Callback tasks for callers who abandoned or asked to be called back';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS agent_state_history;
+DROP TABLE IF EXISTS agent_states;
@@ -0,0 +1,26 @@
+CREATE TABLE agent_states (
+    tenant_id    VARCHAR(64) NOT NULL,
+    identity_id  BIGINT NOT NULL,
+    state        VARCHAR(32) NOT NULL,
+    call_id      BIGINT COMMENT 'The call the agent is ringing for, on or wrapping up',
+    since        BIGINT NOT NULL,
+    PRIMARY KEY (tenant_id, identity_id),
+    INDEX ix__agent_states__call_id (tenant_id, call_id)
+)
+ENGINE=InnoDB
+DEFAULT CHARSET=utf8mb4
+COMMENT='CallHandling Service: The current state of each agent';
+
+CREATE TABLE agent_state_history (
+    period_id    BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    tenant_id    VARCHAR(64) NOT NULL,
+    identity_id  BIGINT NOT NULL,
+    state        VARCHAR(32) NOT NULL,
+    call_id      BIGINT,
+    started_at   BIGINT NOT NULL,
+    ended_at     BIGINT NOT NULL,
+    INDEX ix__agent_state_history__identity_id (tenant_id, identity_id, started_at)
+)
+ENGINE=InnoDB
+DEFAULT CHARSET=utf8mb4
+COMMENT='CallHandling Service: Every completed period an agent spent in a state';
@@ -0,0 +1,2 @@
+ALTER TABLE events
+    DROP INDEX ix__events__identity_timestamp;
@@ -0,0 +1,2 @@
+ALTER TABLE events
+    ADD INDEX ix__events__identity_timestamp (tenant_id, identity_id, timestamp);
@@ -0,0 +1,39 @@
+CREATE TABLE webhook_subscriptions (
+    subscription_id   BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    tenant_id         VARCHAR(64) NOT NULL,
+    url               VARCHAR(2048) NOT NULL,
+    event_types       JSON NOT NULL COMMENT 'Event types delivered, empty delivers every type',
+    DNIS_e164         JSON NOT NULL COMMENT 'Normalized DNIS whose calls are delivered, empty delivers every call',
+    secret            TEXT NOT NULL COMMENT 'Encrypted key payloads are signed with',
+    pii_key_id        VARCHAR(255) NOT NULL,
+    paused            BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at        DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at        DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at        DATETIME,
+    INDEX ix__webhook_subscriptions__tenant_id (tenant_id, deleted_at)
+)
+ENGINE=InnoDB
+DEFAULT CHARSET=utf8mb4
+COMMENT='CallHandling Service: Partner subscriptions to call events delivered over HTTPS';
+
+CREATE TABLE webhook_deliveries (
+    delivery_id       BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    tenant_id         VARCHAR(64) NOT NULL,
+    subscription_id   BIGINT NOT NULL,
+    call_id           BIGINT NOT NULL,
+    event_type        VARCHAR(255) NOT NULL,
+    payload           TEXT NOT NULL COMMENT 'Encrypted JSON body posted to the subscription',
+    pii_key_id        VARCHAR(255) NOT NULL,
+    status            VARCHAR(32) NOT NULL DEFAULT 'pending',
+    attempts          INT NOT NULL DEFAULT 0,
+    next_attempt_at   BIGINT NOT NULL,
+    last_status_code  INT,
+    last_error        VARCHAR(1024),
+    delivered_at      BIGINT,
+    created_at        DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX ix__webhook_deliveries__due (tenant_id, status, next_attempt_at),
+    INDEX ix__webhook_deliveries__subscription_id (tenant_id, subscription_id, delivery_id)
+)
+ENGINE=InnoDB
+DEFAULT CHARSET=utf8mb4
+COMMENT='CallHandling Service: Webhook delivery queue and log, dead deliveries have exhausted their retries';
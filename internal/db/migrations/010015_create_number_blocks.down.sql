@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS blocked_calls;
+DROP TABLE IF EXISTS number_blocks;
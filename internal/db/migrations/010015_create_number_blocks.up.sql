@@ -0,0 +1,32 @@
+CREATE TABLE number_blocks (
+    block_id          BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    tenant_id         VARCHAR(64) NOT NULL,
+    list              VARCHAR(32) NOT NULL COMMENT 'blocklist screens inbound callers, dnc screens outbound calls',
+    number            TEXT COMMENT 'Encrypted E.164 number of an exact entry',
+    number_hash       CHAR(64) COMMENT 'Blind index of the number of an exact entry',
+    prefix            VARCHAR(16) COMMENT 'E.164 prefix of a prefix entry, e.g. +1900',
+    action            VARCHAR(32) NOT NULL DEFAULT 'reject' COMMENT 'reject refuses matching calls, flag only marks them',
+    reason            VARCHAR(255),
+    pii_key_id        VARCHAR(255),
+    created_at        DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE INDEX ux__number_blocks__number_hash (tenant_id, list, number_hash),
+    UNIQUE INDEX ux__number_blocks__prefix (tenant_id, list, prefix)
+)
+ENGINE=InnoDB
+DEFAULT CHARSET=utf8mb4
+COMMENT='CallHandling Service: Blocklisted and do-not-call numbers and prefixes';
+
+CREATE TABLE blocked_calls (
+    blocked_call_id   BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    tenant_id         VARCHAR(64) NOT NULL,
+    call_id           BIGINT NOT NULL COMMENT 'The id the call was created with, rejected calls are not stored',
+    block_id          BIGINT NOT NULL COMMENT 'The entry matched, which may since have been deleted',
+    list              VARCHAR(32) NOT NULL,
+    action            VARCHAR(32) NOT NULL,
+    number_hash       CHAR(64) NOT NULL COMMENT 'Blind index of the matched number, the number itself is never stored',
+    created_at        DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX ix__blocked_calls__created_at (tenant_id, created_at)
+)
+ENGINE=InnoDB
+DEFAULT CHARSET=utf8mb4
+COMMENT='CallHandling Service: Audit trail of calls rejected or flagged by a number block';
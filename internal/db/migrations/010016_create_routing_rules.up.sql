@@ -0,0 +1,11 @@
+CREATE TABLE routing_rule_sets (
+    tenant_id         VARCHAR(64) NOT NULL,
+    version           BIGINT NOT NULL COMMENT 'Increments with each rule set saved, the highest is active',
+    rules             JSON NOT NULL COMMENT 'Ordered routing rules, the first matching a call decides its route',
+    created_by        VARCHAR(255) NOT NULL,
+    created_at        DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tenant_id, version)
+)
+ENGINE=InnoDB
+DEFAULT CHARSET=utf8mb4
+COMMENT='CallHandling Service: Versioned routing rules, rule sets are never modified once saved';
@@ -0,0 +1,3 @@
+ALTER TABLE calls
+    DROP COLUMN DNIS_invalid,
+    DROP COLUMN ANI_invalid;
@@ -0,0 +1,8 @@
+ALTER TABLE calls
+    ADD COLUMN ANI_invalid  BOOLEAN NOT NULL DEFAULT FALSE COMMENT 'Whether an ANI was given that could not be normalized, telling it apart from a missing ANI' AFTER DNIS_e164,
+    ADD COLUMN DNIS_invalid BOOLEAN NOT NULL DEFAULT FALSE COMMENT 'Whether a DNIS was given that could not be normalized, telling it apart from a missing DNIS' AFTER ANI_invalid;
+
+UPDATE calls
+SET
+    ANI_invalid = (ANI IS NOT NULL AND ANI <> '' AND ANI_e164 IS NULL),
+    DNIS_invalid = (DNIS IS NOT NULL AND DNIS <> '' AND DNIS_e164 IS NULL);
@@ -0,0 +1,4 @@
+ALTER TABLE calls
+    DROP COLUMN block_action,
+    DROP COLUMN block_id,
+    DROP COLUMN direction;
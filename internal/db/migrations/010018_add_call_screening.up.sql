@@ -0,0 +1,17 @@
+ALTER TABLE calls
+    ADD COLUMN direction    VARCHAR(16) NOT NULL DEFAULT 'inbound' COMMENT 'inbound calls are screened against the blocklist, outbound calls against the do-not-call list' AFTER DNIS_invalid,
+    ADD COLUMN block_id     BIGINT COMMENT 'The number block that flagged the call, which may since have been deleted' AFTER direction,
+    ADD COLUMN block_action VARCHAR(32) COMMENT 'The action of the number block that flagged the call' AFTER block_id;
+
+UPDATE calls c
+    JOIN blocked_calls b ON b.call_id = c.call_id AND b.tenant_id = c.tenant_id
+SET
+    c.direction = IF(b.list = 'dnc', 'outbound', 'inbound'),
+    c.block_id = b.block_id,
+    c.block_action = b.action;
+
+UPDATE calls
+SET
+    direction = 'outbound'
+WHERE
+    status = 'callback';
@@ -0,0 +1,15 @@
+CREATE TABLE routing_rule_versions (
+    tenant_id         VARCHAR(64) NOT NULL PRIMARY KEY,
+    version           BIGINT NOT NULL COMMENT 'The version of the rule set last saved, incremented in place so concurrent saves are numbered in turn'
+)
+ENGINE=InnoDB
+DEFAULT CHARSET=utf8mb4
+COMMENT='CallHandling Service: Per tenant counter of routing rule set versions';
+
+INSERT INTO routing_rule_versions (tenant_id, version)
+SELECT
+    tenant_id, MAX(version)
+FROM
+    routing_rule_sets
+GROUP BY
+    tenant_id;
@@ -0,0 +1,21 @@
+CREATE TABLE conversation_ids (
+    tenant_id         VARCHAR(64) NOT NULL PRIMARY KEY,
+    last_id           BIGINT NOT NULL COMMENT 'The conversation id last allocated, incremented in place so concurrent starts are given ids in turn'
+)
+ENGINE=InnoDB
+DEFAULT CHARSET=utf8mb4
+COMMENT='CallHandling Service: Per tenant counter of conversation ids';
+
+-- calls created outside a conversation were once stored with 0 rather than NULL
+UPDATE calls SET conversation_id = NULL WHERE conversation_id = 0;
+UPDATE cdrs SET conversation_id = NULL WHERE conversation_id = 0;
+
+INSERT INTO conversation_ids (tenant_id, last_id)
+SELECT
+    tenant_id, MAX(conversation_id)
+FROM
+    calls
+WHERE
+    conversation_id IS NOT NULL
+GROUP BY
+    tenant_id;
@@ -0,0 +1,7 @@
+-- the DNIS columns stay wide, the DNIS of outbound calls stays encrypted
+ALTER TABLE cdrs
+    DROP COLUMN DNIS_hash;
+
+ALTER TABLE calls
+    DROP INDEX ix__calls__DNIS_hash,
+    DROP COLUMN DNIS_hash;
@@ -0,0 +1,18 @@
+ALTER TABLE calls
+    MODIFY COLUMN DNIS      VARCHAR(512) COMMENT 'Number dialed, encrypted under pii_key_id for an outbound call, where it is the party called',
+    MODIFY COLUMN DNIS_e164 VARCHAR(512) COMMENT 'DNIS normalized to E.164, encrypted like DNIS, NULL when the raw DNIS could not be parsed',
+    ADD COLUMN DNIS_hash    CHAR(64) COMMENT 'Blind index (HMAC-SHA256) of the plaintext DNIS_e164 of an outbound call, set once its DNIS columns are encrypted' AFTER ANI_hash,
+    ADD INDEX ix__calls__DNIS_hash (tenant_id, DNIS_hash);
+
+ALTER TABLE cdrs
+    ADD COLUMN DNIS_hash    CHAR(64) COMMENT 'Blind index of the party an outbound call was placed to, the number itself is never stored' AFTER ANI_hash;
+
+-- the CDRs of outbound calls held the number called. It cannot be blind indexed here, the
+-- calls keep it until the re-encryption job encrypts their DNIS columns.
+UPDATE cdrs
+    JOIN calls ON calls.call_id = cdrs.call_id AND calls.tenant_id = cdrs.tenant_id
+SET
+    cdrs.DNIS = NULL,
+    cdrs.DNIS_e164 = NULL
+WHERE
+    calls.direction = 'outbound';
@@ -0,0 +1,228 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/caring/go-packages/pkg/errors"
+
+	"github.com/caring/call-handling/internal/fieldcrypt"
+)
+
+// openPII decrypts a PII column value. Rows written before encryption was introduced
+// have no key id and are returned as stored until the re-encryption job reaches them.
+func openPII(ctx context.Context, enc *fieldcrypt.Encrypter, keyID sql.NullString, value string) (string, error) {
+	if !keyID.Valid {
+		return value, nil
+	}
+	return enc.Decrypt(ctx, value)
+}
+
+// RekeySkip is a row left sealed under its old master key because its PII could not be
+// decrypted. It is reported rather than retried so that one bad row cannot stall a rotation.
+type RekeySkip struct {
+	ID  int64
+	Err error
+}
+
+// Rekeyer re-encrypts up to limit rows of a table whose PII is not sealed under the current
+// master key, past the first offset stale rows, which are the ones skipped so far. It returns
+// how many rows were rewritten and the rows skipped, and is meant to be called repeatedly by
+// a background job, moving offset past the skipped rows, until a batch finds no rows.
+type Rekeyer func(ctx context.Context, offset, limit int) (int, []RekeySkip, error)
+
+// Rekey re-encrypts stale calls across all tenants, and encrypts the number called of
+// outbound calls stored before it was. See Rekeyer.
+func (svc *callService) Rekey(ctx context.Context, offset, limit int) (int, []RekeySkip, error) {
+	errMsg := func() string { return "Error executing rekey calls - " + fmt.Sprint(limit) }
+
+	type row struct {
+		ID        int64
+		ANI       sql.NullString
+		ANIE164   sql.NullString
+		DNIS      sql.NullString
+		DNISE164  sql.NullString
+		DNISHash  sql.NullString
+		Direction string
+		KeyID     sql.NullString
+	}
+
+	rows, err := svc.stmts["list-calls-to-rekey"].QueryContext(ctx, svc.enc.CurrentKeyID(), limit, offset)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, errMsg())
+	}
+
+	pending := []row{}
+	for rows.Next() {
+		r := row{}
+		if err = rows.Scan(&r.ID, &r.ANI, &r.ANIE164, &r.DNIS, &r.DNISE164, &r.DNISHash, &r.Direction, &r.KeyID); err != nil {
+			rows.Close()
+			return 0, nil, errors.Wrap(err, errMsg())
+		}
+		pending = append(pending, r)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, nil, errors.Wrap(err, errMsg())
+	}
+
+	var (
+		rekeyed = 0
+		skipped = []RekeySkip{}
+	)
+	for _, r := range pending {
+		input := Call{Direction: r.Direction}
+		if input.ANI, err = openPII(ctx, svc.enc, r.KeyID, r.ANI.String); err == nil {
+			input.ANIE164, err = openPII(ctx, svc.enc, r.KeyID, r.ANIE164.String)
+		}
+		if err == nil {
+			input.DNIS, input.DNISE164, err = openDNIS(ctx, svc.enc, r.KeyID, r.DNISHash, r.DNIS.String, r.DNISE164.String)
+		}
+		if err != nil {
+			skipped = append(skipped, RekeySkip{ID: r.ID, Err: errors.Wrap(err, errMsg())})
+			continue
+		}
+
+		sealed, err := svc.seal(ctx, &input)
+		if err != nil {
+			return rekeyed, skipped, errors.Wrap(err, errMsg())
+		}
+
+		result, err := svc.stmts["rekey-call"].ExecContext(ctx, keepNull(r.ANI, sealed.ANI), keepNull(r.ANIE164, sealed.ANIE164),
+			nullString(sealed.ANIHash), keepNull(r.DNIS, sealed.DNIS), keepNull(r.DNISE164, sealed.DNISE164), sealed.DNISHash,
+			sealed.KeyID, r.ID, r.KeyID, r.DNISHash)
+		if err != nil {
+			return rekeyed, skipped, errors.Wrap(err, errMsg())
+		}
+
+		// a row rewritten concurrently is skipped, it will be picked up again if still stale
+		if n, err := result.RowsAffected(); err == nil && n > 0 {
+			rekeyed++
+		}
+	}
+
+	return rekeyed, skipped, nil
+}
+
+// keepNull gives value the NULL-ness of the column it replaces, so that an empty value stays
+// an empty string in a NOT NULL column and a NULL stays NULL
+func keepNull(old sql.NullString, value string) sql.NullString {
+	return sql.NullString{String: value, Valid: old.Valid}
+}
+
+// Rekeyers returns the re-encryption of every table holding sealed PII, keyed by table
+// name. A table whose rows carry a pii_key_id must be listed here, or rows sealed under a
+// retired master key would become unreadable once the key is removed.
+func (s *Store) Rekeyers() map[string]Rekeyer {
+	return map[string]Rekeyer{
+		"calls":                 s.Calls.Rekey,
+		"events":                s.Events.Rekey,
+		"webhook_subscriptions": s.Webhooks.RekeySubscriptions,
+		"webhook_deliveries":    s.Webhooks.RekeyDeliveries,
+		"number_blocks":         s.Blocks.Rekey,
+	}
+}
+
+// Rekey re-encrypts stale events. See Rekeyer.
+func (svc *eventService) Rekey(ctx context.Context, offset, limit int) (int, []RekeySkip, error) {
+	errMsg := func() string { return "Error executing rekey events - " + fmt.Sprint(limit) }
+
+	n, skipped, err := rekeyColumn(ctx, svc.stmts, svc.enc, "list-events-to-rekey", "rekey-event", offset, limit)
+	if err != nil {
+		return n, skipped, errors.Wrap(err, errMsg())
+	}
+	return n, skipped, nil
+}
+
+// RekeySubscriptions re-encrypts stale webhook subscription secrets. See Rekeyer.
+func (svc *webhookService) RekeySubscriptions(ctx context.Context, offset, limit int) (int, []RekeySkip, error) {
+	errMsg := func() string { return "Error executing rekey webhook subscriptions - " + fmt.Sprint(limit) }
+
+	n, skipped, err := rekeyColumn(ctx, svc.stmts, svc.enc, "list-webhook-subscriptions-to-rekey", "rekey-webhook-subscription", offset, limit)
+	if err != nil {
+		return n, skipped, errors.Wrap(err, errMsg())
+	}
+	return n, skipped, nil
+}
+
+// RekeyDeliveries re-encrypts stale webhook delivery payloads. See Rekeyer.
+func (svc *webhookService) RekeyDeliveries(ctx context.Context, offset, limit int) (int, []RekeySkip, error) {
+	errMsg := func() string { return "Error executing rekey webhook deliveries - " + fmt.Sprint(limit) }
+
+	n, skipped, err := rekeyColumn(ctx, svc.stmts, svc.enc, "list-webhook-deliveries-to-rekey", "rekey-webhook-delivery", offset, limit)
+	if err != nil {
+		return n, skipped, errors.Wrap(err, errMsg())
+	}
+	return n, skipped, nil
+}
+
+// Rekey re-encrypts stale number block numbers, whose blind index is unchanged by a
+// rotation. See Rekeyer.
+func (svc *blockService) Rekey(ctx context.Context, offset, limit int) (int, []RekeySkip, error) {
+	errMsg := func() string { return "Error executing rekey number blocks - " + fmt.Sprint(limit) }
+
+	n, skipped, err := rekeyColumn(ctx, svc.stmts, svc.enc, "list-number-blocks-to-rekey", "rekey-number-block", offset, limit)
+	if err != nil {
+		return n, skipped, errors.Wrap(err, errMsg())
+	}
+	return n, skipped, nil
+}
+
+// rekeyColumn re-encrypts the single sealed column of up to limit rows. list selects the
+// stale rows past offset as (id, value, pii_key_id), update sets (value, pii_key_id) where
+// the id and the old key id still match. Rows that cannot be decrypted are skipped.
+func rekeyColumn(ctx context.Context, stmts map[string]*sql.Stmt, enc *fieldcrypt.Encrypter, list, update string, offset, limit int) (int, []RekeySkip, error) {
+	type row struct {
+		ID    int64
+		Value sql.NullString
+		KeyID sql.NullString
+	}
+
+	rows, err := stmts[list].QueryContext(ctx, enc.CurrentKeyID(), limit, offset)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	pending := []row{}
+	for rows.Next() {
+		r := row{}
+		if err = rows.Scan(&r.ID, &r.Value, &r.KeyID); err != nil {
+			rows.Close()
+			return 0, nil, err
+		}
+		pending = append(pending, r)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, nil, err
+	}
+
+	var (
+		rekeyed = 0
+		skipped = []RekeySkip{}
+	)
+	for _, r := range pending {
+		value, err := openPII(ctx, enc, r.KeyID, r.Value.String)
+		if err != nil {
+			skipped = append(skipped, RekeySkip{ID: r.ID, Err: err})
+			continue
+		}
+
+		if value, err = enc.Encrypt(ctx, value); err != nil {
+			return rekeyed, skipped, err
+		}
+
+		result, err := stmts[update].ExecContext(ctx, keepNull(r.Value, value), enc.CurrentKeyID(), r.ID, r.KeyID)
+		if err != nil {
+			return rekeyed, skipped, err
+		}
+
+		// a row rewritten concurrently is skipped, it will be picked up again if still stale
+		if n, err := result.RowsAffected(); err == nil && n > 0 {
+			rekeyed++
+		}
+	}
+
+	return rekeyed, skipped, nil
+}
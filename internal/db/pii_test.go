@@ -0,0 +1,176 @@
+package db
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// ensures that every table the migrations give a pii_key_id column is re-encrypted
+func TestStore_rekeyersCoverSealedTables(t *testing.T) {
+	files, err := filepath.Glob("migrations/*.up.sql")
+	if ok := assert.NoError(t, err, "Expected no error"); !ok || len(files) == 0 {
+		assert.FailNow(t, "no migrations found")
+	}
+
+	table := regexp.MustCompile(`(?i)(?:CREATE|ALTER)\s+TABLE\s+(\w+)`)
+	sealed := map[string]bool{}
+	for _, f := range files {
+		raw, err := ioutil.ReadFile(f)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "reading "+f+" failed")
+		}
+
+		for _, stmt := range strings.Split(string(raw), ";") {
+			if m := table.FindStringSubmatch(stmt); m != nil && strings.Contains(stmt, "pii_key_id") {
+				sealed[m[1]] = true
+			}
+		}
+	}
+
+	store, _, err := NewTestDB(map[string]string{})
+	if ok := assert.NoError(t, err, "Expected no error"); !ok {
+		assert.FailNow(t, "test setup failed")
+	}
+
+	rekeyers := store.Rekeyers()
+	for name := range sealed {
+		assert.Contains(t, rekeyers, name, "Expected the sealed table to be re-encrypted")
+	}
+	for name := range rekeyers {
+		assert.Contains(t, sealed, name, "Expected only sealed tables to be re-encrypted")
+	}
+}
+
+func TestBlock_rekey(t *testing.T) {
+	stmt := map[string]string{
+		"list-number-blocks-to-rekey": "SELECT block_id, number, pii_key_id FROM number_blocks",
+		"rekey-number-block":          "UPDATE number_blocks",
+	}
+
+	// ensures that a number stored before encryption is sealed under the current key
+	t.Run("Unsealed number", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectQuery("SELECT block_id, number, pii_key_id FROM number_blocks").
+			WithArgs(testKeyID, 10, 0).
+			WillReturnRows(sqlmock.NewRows([]string{"block_id", "number", "pii_key_id"}).
+				AddRow(7, "+15125551234", nil))
+		mock.ExpectExec("UPDATE number_blocks").
+			WithArgs(sealedArg{"+15125551234"}, testKeyID, 7, nil).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		n, skipped, err := store.Blocks.Rekey(context.Background(), 0, 10)
+		assert.NoError(t, err, "Expecting no query error")
+		assert.Equal(t, 1, n, "Expected the row to be re-encrypted")
+		assert.Empty(t, skipped, "Expected no row to be skipped")
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+
+	// ensures that a row which cannot be decrypted is skipped and reported while the rest of
+	// the batch is re-encrypted
+	t.Run("Corrupt number", func(t *testing.T) {
+		store, mock, err := NewTestDB(stmt)
+		if ok := assert.NoError(t, err, "Expected no error"); !ok {
+			assert.FailNow(t, "test setup failed")
+		}
+
+		mock.ExpectQuery("SELECT block_id, number, pii_key_id FROM number_blocks").
+			WithArgs(testKeyID, 10, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"block_id", "number", "pii_key_id"}).
+				AddRow(7, "v1.corrupt", "retired-key").
+				AddRow(8, "+15125550000", nil))
+		mock.ExpectExec("UPDATE number_blocks").
+			WithArgs(sealedArg{"+15125550000"}, testKeyID, 8, nil).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		n, skipped, err := store.Blocks.Rekey(context.Background(), 2, 10)
+		assert.NoError(t, err, "Expecting no query error")
+		assert.Equal(t, 1, n, "Expected the readable row to be re-encrypted")
+		if assert.Len(t, skipped, 1, "Expected the corrupt row to be skipped") {
+			assert.Equal(t, int64(7), skipped[0].ID, "Expected the corrupt row to be reported")
+		}
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err, "Expecting all mock conditions to be met")
+	})
+}
+
+// ensures that the plaintext number called by an outbound call stored before it was sealed is
+// encrypted and blind indexed
+func TestCall_rekeyOutboundDNIS(t *testing.T) {
+	stmt := map[string]string{
+		"list-calls-to-rekey": "SELECT call_id FROM calls",
+		"rekey-call":          "UPDATE calls",
+	}
+	enc, err := NewTestEncrypter()
+	if ok := assert.NoError(t, err, "Expected no error"); !ok {
+		assert.FailNow(t, "test setup failed")
+	}
+
+	store, mock, err := NewTestDB(stmt)
+	if ok := assert.NoError(t, err, "Expected no error"); !ok {
+		assert.FailNow(t, "test setup failed")
+	}
+
+	ANI, err := enc.Encrypt(context.Background(), "8005550100")
+	if ok := assert.NoError(t, err, "Expected no error"); !ok {
+		assert.FailNow(t, "test setup failed")
+	}
+	mock.ExpectQuery("SELECT call_id FROM calls").
+		WithArgs(testKeyID, 10, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"call_id", "ANI", "ANI_e164", "DNIS", "DNIS_e164", "DNIS_hash", "direction", "pii_key_id"}).
+			AddRow(2000, ANI, nil, "5125551234", "+15125551234", nil, "outbound", testKeyID))
+	mock.ExpectExec("UPDATE calls").
+		WithArgs(sealedArg{"8005550100"}, nil, nil, sealedArg{"5125551234"}, sealedArg{"+15125551234"},
+			enc.BlindIndex("+15125551234"), testKeyID, 2000, testKeyID, nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	n, skipped, err := store.Calls.Rekey(context.Background(), 0, 10)
+	assert.NoError(t, err, "Expecting no query error")
+	assert.Equal(t, 1, n, "Expected the row to be re-encrypted")
+	assert.Empty(t, skipped, "Expected no row to be skipped")
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err, "Expecting all mock conditions to be met")
+}
+
+// ensures that an empty value in a NOT NULL column is written back empty rather than NULL
+func TestWebhook_rekeySubscriptions(t *testing.T) {
+	stmt := map[string]string{
+		"list-webhook-subscriptions-to-rekey": "SELECT subscription_id, secret, pii_key_id FROM webhook_subscriptions",
+		"rekey-webhook-subscription":          "UPDATE webhook_subscriptions",
+	}
+
+	store, mock, err := NewTestDB(stmt)
+	if ok := assert.NoError(t, err, "Expected no error"); !ok {
+		assert.FailNow(t, "test setup failed")
+	}
+
+	mock.ExpectQuery("SELECT subscription_id, secret, pii_key_id FROM webhook_subscriptions").
+		WithArgs(testKeyID, 10, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"subscription_id", "secret", "pii_key_id"}).
+			AddRow(3, "", "retired-key"))
+	mock.ExpectExec("UPDATE webhook_subscriptions").
+		WithArgs("", testKeyID, 3, "retired-key").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	n, skipped, err := store.Webhooks.RekeySubscriptions(context.Background(), 0, 10)
+	assert.NoError(t, err, "Expecting no query error")
+	assert.Equal(t, 1, n, "Expected the row to be re-encrypted")
+	assert.Empty(t, skipped, "Expected no row to be skipped")
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err, "Expecting all mock conditions to be met")
+}
@@ -0,0 +1,54 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+// retentionService provides an API for purging call data that is past its retention period.
+// Every purge acts on the tenant in context and skips calls under legal hold.
+type retentionService struct {
+	db    *sql.DB
+	stmts map[string]*sql.Stmt
+}
+
+// PurgeEvents deletes up to limit events created before cutoff
+func (svc *retentionService) PurgeEvents(ctx context.Context, cutoff time.Time, limit int) (int64, error) {
+	return svc.purge(ctx, "purge-events", cutoff, limit)
+}
+
+// PurgeEventMeta clears meta from up to limit events created before cutoff
+func (svc *retentionService) PurgeEventMeta(ctx context.Context, cutoff time.Time, limit int) (int64, error) {
+	return svc.purge(ctx, "purge-event-meta", cutoff, limit)
+}
+
+// AnonymizeANI clears the caller's number from up to limit calls created before cutoff
+func (svc *retentionService) AnonymizeANI(ctx context.Context, cutoff time.Time, limit int) (int64, error) {
+	return svc.purge(ctx, "anonymize-call-ani", cutoff, limit)
+}
+
+// purge runs a single batch of a purge statement, returning the number of rows it touched
+func (svc *retentionService) purge(ctx context.Context, stmtName string, cutoff time.Time, limit int) (int64, error) {
+	errMsg := func() string { return "Error executing " + stmtName + " - " + fmt.Sprint(cutoff) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, errMsg())
+	}
+
+	result, err := svc.stmts[stmtName].ExecContext(ctx, tenant.ID, cutoff.UTC(), limit)
+	if err != nil {
+		return 0, errors.Wrap(err, errMsg())
+	}
+
+	rowCount, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, errMsg())
+	}
+
+	return rowCount, nil
+}
@@ -0,0 +1,188 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+// routingService provides an API for interacting with the routing_rule_sets table
+type routingService struct {
+	db    *sql.DB
+	stmts map[string]*sql.Stmt
+}
+
+// RoutingRuleSet is a struct representation of a row in the routing_rule_sets table. Rule
+// sets are immutable, changing the rules saves a new version and the highest version is active.
+type RoutingRuleSet struct {
+	TenantID  string
+	Version   int64
+	Rules     []RoutingRule
+	CreatedBy string
+	CreatedAt time.Time
+}
+
+// RoutingRule routes the calls matching every one of its conditions. Unset conditions match any call.
+type RoutingRule struct {
+	Name string `json:"name,omitempty"`
+	// DNIS are the E.164 numbers dialed
+	DNIS []string `json:"dnis,omitempty"`
+	// ANIPrefixes are E.164 prefixes of the caller's number
+	ANIPrefixes []string `json:"ani_prefixes,omitempty"`
+	// Hours restricts the rule to calls within them in the tenant's timezone, or outside
+	// them when OutsideHours is set
+	Hours        *BusinessHours `json:"hours,omitempty"`
+	OutsideHours bool           `json:"outside_hours,omitempty"`
+	// MinPriorCalls is the fewest earlier calls the caller must have made
+	MinPriorCalls int `json:"min_prior_calls,omitempty"`
+	// RepeatWithinMinutes requires the caller's last call to be at most this long ago
+	RepeatWithinMinutes int `json:"repeat_within_minutes,omitempty"`
+	// LastDispositions requires the caller's last call to have ended in one of them
+	LastDispositions []string `json:"last_dispositions,omitempty"`
+
+	// Queue, Priority and Skills are the route of the calls matched
+	Queue    string   `json:"queue"`
+	Priority int      `json:"priority,omitempty"`
+	Skills   []string `json:"skills,omitempty"`
+}
+
+// Create saves a rule set as the tenant's next version, setting its Version
+func (svc *routingService) Create(ctx context.Context, input *RoutingRuleSet) error {
+	errMsg := func() string { return "Error executing create routing rule set - " + fmt.Sprint(len(input.Rules)) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+	input.TenantID = tenant.ID
+
+	rules, err := json.Marshal(input.Rules)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	// the counter row stays locked until the rule set is saved, so concurrent saves are
+	// numbered in turn rather than racing for the same version
+	if _, err = tx.Stmt(svc.stmts["increment-routing-rule-version"]).ExecContext(ctx, tenant.ID); err != nil {
+		tx.Rollback()
+		return errors.Wrap(err, errMsg())
+	}
+
+	if err = tx.Stmt(svc.stmts["get-routing-rule-version"]).QueryRowContext(ctx, tenant.ID).Scan(&input.Version); err != nil {
+		tx.Rollback()
+		return errors.Wrap(err, errMsg())
+	}
+
+	if _, err = tx.Stmt(svc.stmts["create-routing-rule-set"]).ExecContext(ctx, tenant.ID, input.Version, rules, input.CreatedBy); err != nil {
+		tx.Rollback()
+		return errors.Wrap(err, errMsg())
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	return nil
+}
+
+// Get fetches a single version of the tenant's rules
+func (svc *routingService) Get(ctx context.Context, version int64) (*RoutingRuleSet, error) {
+	errMsg := func() string { return "Error executing get routing rule set - " + fmt.Sprint(version) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	p, err := scanRoutingRuleSet(svc.stmts["get-routing-rule-set"].QueryRowContext(ctx, tenant.ID, version))
+	if err != nil {
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrap(ErrNotFound, errMsg())
+		}
+
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return p, nil
+}
+
+// GetActive fetches the most recent version of the tenant's rules
+func (svc *routingService) GetActive(ctx context.Context) (*RoutingRuleSet, error) {
+	errMsg := func() string { return "Error executing get active routing rule set" }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	p, err := scanRoutingRuleSet(svc.stmts["get-active-routing-rule-set"].QueryRowContext(ctx, tenant.ID))
+	if err != nil {
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrap(ErrNotFound, errMsg())
+		}
+
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return p, nil
+}
+
+// List fetches the most recent versions of the tenant's rules
+func (svc *routingService) List(ctx context.Context, limit int) ([]*RoutingRuleSet, error) {
+	errMsg := func() string { return "Error executing list routing rule sets - " + fmt.Sprint(limit) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	rows, err := svc.stmts["list-routing-rule-sets"].QueryContext(ctx, tenant.ID, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+	defer rows.Close()
+
+	sets := []*RoutingRuleSet{}
+	for rows.Next() {
+		p, err := scanRoutingRuleSet(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errMsg())
+		}
+		sets = append(sets, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return sets, nil
+}
+
+// scanRoutingRuleSet reads a routing_rule_sets row, decoding its rules
+func scanRoutingRuleSet(row rowScanner) (*RoutingRuleSet, error) {
+	var (
+		p     = RoutingRuleSet{}
+		rules []byte
+	)
+
+	if err := row.Scan(&p.TenantID, &p.Version, &rules, &p.CreatedBy, &p.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(rules, &p.Rules); err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
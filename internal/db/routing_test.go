@@ -0,0 +1,44 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouting_create(t *testing.T) {
+	stmt := map[string]string{
+		"increment-routing-rule-version": "INSERT INTO routing_rule_versions",
+		"get-routing-rule-version":       "SELECT routing_rule_versions",
+		"create-routing-rule-set":        "INSERT INTO routing_rule_sets",
+	}
+
+	store, mock, err := NewTestDB(stmt)
+	if ok := assert.NoError(t, err, "Expected no error"); !ok {
+		assert.FailNow(t, "test setup failed")
+	}
+
+	// ensures that the version counter is incremented and the rule set saved as it in one transaction
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO routing_rule_versions").
+		WithArgs("tenant-a").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectQuery("SELECT routing_rule_versions").
+		WithArgs("tenant-a").
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(int64(3)))
+	mock.ExpectExec("INSERT INTO routing_rule_sets").
+		WithArgs("tenant-a", int64(3), []byte(`[{"name":"default","queue":"sales"}]`), "ops").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	ctx := TenantToCtx(context.Background(), &Tenant{ID: "tenant-a"})
+	set := &RoutingRuleSet{Rules: []RoutingRule{{Name: "default", Queue: "sales"}}, CreatedBy: "ops"}
+	err = store.Routing.Create(ctx, set)
+	assert.NoError(t, err, "Expecting no query error")
+	assert.Equal(t, int64(3), set.Version, "Expected the version to be set")
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err, "Expecting all mock conditions to be met")
+}
@@ -1,32 +1,940 @@
 package db
 
+// multi-row inserts cannot be prepared ahead of time, they are built from these
+// by repeating the row placeholders once per row
+const (
+	// inserts a batch of rows into the events table
+	createEventsPrefix = `
+  INSERT INTO events (call_id, tenant_id, type, identity_id, timestamp, meta, pii_key_id)
+    values`
+	createEventsRow = `(?, ?, ?, ?, ?, ?, ?)`
+)
+
 var statements = map[string]string{
 	// inserts a new row into the calls table
 	"create-call": `
-  INSERT INTO calls (call_id, sid, conversation_id, ANI, DNIS, status)
-    values(?, ?, ?, ?, ?, ?)
+  INSERT INTO calls (call_id, tenant_id, sid, conversation_id, ANI, DNIS, ANI_e164, DNIS_e164, ANI_invalid, DNIS_invalid, direction, block_id, block_action, ANI_hash, DNIS_hash, status, pii_key_id)
+    values(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
   `,
 	// gets a single call row by id
 	"get-call": `
   SELECT
-    *
+    call_id, tenant_id, sid, conversation_id, ANI, DNIS, ANI_e164, DNIS_e164, ANI_invalid, DNIS_invalid, direction, block_id, block_action, DNIS_hash, status, outcome, pii_key_id, created_at
   FROM
     calls
   WHERE
-    call_id = ?
+    call_id = ? AND tenant_id = ? AND deleted_at IS NULL
+  `,
+	// lists the most recent calls from a normalized ANI, matched by its blind index
+	"list-calls-by-ani": `
+  SELECT
+    call_id, tenant_id, sid, conversation_id, ANI, DNIS, ANI_e164, DNIS_e164, ANI_invalid, DNIS_invalid, direction, block_id, block_action, DNIS_hash, status, outcome, pii_key_id, created_at
+  FROM
+    calls
+  WHERE
+    tenant_id = ? AND ANI_hash = ? AND deleted_at IS NULL
+  ORDER BY
+    call_id DESC
+  LIMIT ?
+  `,
+	// counts the calls from or placed to a normalized number, matched by its blind index, and
+	// fetches the most recent, leaving out a single call
+	"get-caller-history": `
+  SELECT
+    h.calls, c.call_id, c.created_at
+  FROM
+    (
+      SELECT
+        COUNT(*) AS calls
+      FROM
+        calls
+      WHERE
+        tenant_id = ? AND (ANI_hash = ? OR DNIS_hash = ?) AND call_id <> ? AND deleted_at IS NULL
+    ) h
+    LEFT JOIN (
+      SELECT
+        call_id, created_at
+      FROM
+        calls
+      WHERE
+        tenant_id = ? AND (ANI_hash = ? OR DNIS_hash = ?) AND call_id <> ? AND deleted_at IS NULL
+      ORDER BY
+        created_at DESC, call_id DESC
+      LIMIT 1
+    ) c ON TRUE
+  `,
+	// updates a single call row by id. The DNIS columns take their encrypted form, the first of
+	// each pair, on an outbound call, whose direction is left as it was created.
+	"update-call": `
+  UPDATE calls
+  SET
+    sid = ?, conversation_id = ?, ANI = ?, DNIS = IF(direction = 'outbound', ?, ?), ANI_e164 = ?,
+    DNIS_e164 = IF(direction = 'outbound', ?, ?), ANI_invalid = ?, DNIS_invalid = ?, ANI_hash = ?,
+    DNIS_hash = IF(direction = 'outbound', ?, NULL), status = ?, pii_key_id = ?
+  WHERE
+    call_id = ? AND tenant_id = ? AND deleted_at IS NULL
+  `,
+	// soft deletes a single call row by id
+	"delete-call": `
+  UPDATE calls
+  SET
+    deleted_at = CURRENT_TIMESTAMP
+  WHERE
+    call_id = ? AND tenant_id = ? AND deleted_at IS NULL
   `,
 	// inserts a new row into the events table
 	"create-event": `
-  INSERT INTO events (call_id, type, identity_id, timestamp, meta)
-	values(?, ?, ?, ?, ?)
+  INSERT INTO events (call_id, tenant_id, type, identity_id, timestamp, meta, pii_key_id)
+	values(?, ?, ?, ?, ?, ?, ?)
 	`,
 	// gets a single event row by id
-	"get_event": `
+	"get-event": `
   SELECT
-    *
+    call_id, tenant_id, type, identity_id, timestamp, meta, pii_key_id
   FROM
     events
   WHERE
-    call_id = ?
+    call_id = ? AND tenant_id = ?
 	`,
+	// gets a single tenant row by id
+	"get-tenant": `
+  SELECT
+    tenant_id, name, config
+  FROM
+    tenants
+  WHERE
+    tenant_id = ? AND deleted_at IS NULL
+  `,
+	// lists calls whose PII is not encrypted under the current master key, or outbound calls
+	// whose number called is not encrypted yet, past those skipped
+	"list-calls-to-rekey": `
+  SELECT
+    call_id, ANI, ANI_e164, DNIS, DNIS_e164, DNIS_hash, direction, pii_key_id
+  FROM
+    calls
+  WHERE
+    pii_key_id IS NULL OR pii_key_id <> ?
+    OR (direction = 'outbound' AND DNIS_hash IS NULL AND DNIS IS NOT NULL AND DNIS <> '')
+  ORDER BY
+    call_id
+  LIMIT ? OFFSET ?
+  `,
+	// replaces the PII of a single call, guarded against concurrent re-encryption
+	"rekey-call": `
+  UPDATE calls
+  SET
+    ANI = ?, ANI_e164 = ?, ANI_hash = ?, DNIS = ?, DNIS_e164 = ?, DNIS_hash = ?, pii_key_id = ?
+  WHERE
+    call_id = ? AND pii_key_id <=> ? AND DNIS_hash <=> ?
+  `,
+	// lists events whose PII is not encrypted under the current master key, past those skipped
+	"list-events-to-rekey": `
+  SELECT
+    event_id, meta, pii_key_id
+  FROM
+    events
+  WHERE
+    pii_key_id IS NULL OR pii_key_id <> ?
+  ORDER BY
+    event_id
+  LIMIT ? OFFSET ?
+  `,
+	// replaces the PII of a single event, guarded against concurrent re-encryption
+	"rekey-event": `
+  UPDATE events
+  SET
+    meta = ?, pii_key_id = ?
+  WHERE
+    event_id = ? AND pii_key_id <=> ?
+  `,
+	// lists webhook subscriptions whose secret is not encrypted under the current master key, past those skipped
+	"list-webhook-subscriptions-to-rekey": `
+  SELECT
+    subscription_id, secret, pii_key_id
+  FROM
+    webhook_subscriptions
+  WHERE
+    pii_key_id <> ?
+  ORDER BY
+    subscription_id
+  LIMIT ? OFFSET ?
+  `,
+	// replaces the secret of a single webhook subscription, guarded against concurrent re-encryption
+	"rekey-webhook-subscription": `
+  UPDATE webhook_subscriptions
+  SET
+    secret = ?, pii_key_id = ?
+  WHERE
+    subscription_id = ? AND pii_key_id <=> ?
+  `,
+	// lists webhook deliveries whose payload is not encrypted under the current master key, past those skipped
+	"list-webhook-deliveries-to-rekey": `
+  SELECT
+    delivery_id, payload, pii_key_id
+  FROM
+    webhook_deliveries
+  WHERE
+    pii_key_id <> ?
+  ORDER BY
+    delivery_id
+  LIMIT ? OFFSET ?
+  `,
+	// replaces the payload of a single webhook delivery, guarded against concurrent re-encryption
+	"rekey-webhook-delivery": `
+  UPDATE webhook_deliveries
+  SET
+    payload = ?, pii_key_id = ?
+  WHERE
+    delivery_id = ? AND pii_key_id <=> ?
+  `,
+	// lists exact number blocks whose number is not encrypted under the current master key, past those skipped
+	"list-number-blocks-to-rekey": `
+  SELECT
+    block_id, number, pii_key_id
+  FROM
+    number_blocks
+  WHERE
+    number IS NOT NULL AND (pii_key_id IS NULL OR pii_key_id <> ?)
+  ORDER BY
+    block_id
+  LIMIT ? OFFSET ?
+  `,
+	// replaces the number of a single number block, guarded against concurrent re-encryption
+	"rekey-number-block": `
+  UPDATE number_blocks
+  SET
+    number = ?, pii_key_id = ?
+  WHERE
+    block_id = ? AND pii_key_id <=> ?
+  `,
+	// lists every active tenant
+	"list-tenants": `
+  SELECT
+    tenant_id, name, config
+  FROM
+    tenants
+  WHERE
+    deleted_at IS NULL
+  `,
+	// places or lifts a legal hold on a single call
+	"set-legal-hold": `
+  UPDATE calls
+  SET
+    legal_hold = ?, legal_hold_reason = ?
+  WHERE
+    call_id = ? AND tenant_id = ? AND deleted_at IS NULL
+  `,
+	// deletes a batch of events older than a cutoff, skipping calls under legal hold
+	"purge-events": `
+  DELETE FROM events
+  WHERE
+    tenant_id = ? AND created_at < ?
+    AND NOT EXISTS (SELECT 1 FROM calls WHERE calls.call_id = events.call_id AND calls.legal_hold)
+  LIMIT ?
+  `,
+	// clears meta from a batch of events older than a cutoff, skipping calls under legal hold
+	"purge-event-meta": `
+  UPDATE events
+  SET
+    meta = NULL
+  WHERE
+    tenant_id = ? AND created_at < ? AND meta IS NOT NULL
+    AND NOT EXISTS (SELECT 1 FROM calls WHERE calls.call_id = events.call_id AND calls.legal_hold)
+  LIMIT ?
+  `,
+	// clears ANI, and DNIS where it is the party an outbound call was placed to, from a batch
+	// of calls older than a cutoff, skipping calls under legal hold
+	"anonymize-call-ani": `
+  UPDATE calls
+  SET
+    ANI = NULL, ANI_e164 = NULL, ANI_hash = NULL,
+    DNIS = IF(direction = 'outbound', NULL, DNIS), DNIS_e164 = IF(direction = 'outbound', NULL, DNIS_e164), DNIS_hash = NULL,
+    anonymized_at = CURRENT_TIMESTAMP
+  WHERE
+    tenant_id = ? AND created_at < ? AND NOT legal_hold AND anonymized_at IS NULL
+  LIMIT ?
+  `,
+	// locks every call of a tenant from or to a caller, matched by the blind index of their number
+	"list-calls-for-erasure": `
+  SELECT
+    call_id, legal_hold
+  FROM
+    calls
+  WHERE
+    tenant_id = ? AND (ANI_hash = ? OR DNIS_hash = ?)
+  FOR UPDATE
+  `,
+	// lists the calls with a caller number but no blind index, which are written before
+	// numbers were normalized or encrypted and are matched by their number instead. Outbound
+	// calls are listed the same way for the number called.
+	"list-unhashed-calls-for-erasure": `
+  SELECT
+    call_id, legal_hold, ANI, ANI_e164, ANI_hash, DNIS, DNIS_e164, DNIS_hash, direction, pii_key_id
+  FROM
+    calls
+  WHERE
+    tenant_id = ? AND (
+      ((ANI_hash IS NULL OR ANI_hash = '') AND ANI IS NOT NULL AND ANI <> '')
+      OR (direction = 'outbound' AND (DNIS_hash IS NULL OR DNIS_hash = '') AND DNIS IS NOT NULL AND DNIS <> '')
+    )
+  FOR UPDATE
+  `,
+	// clears the caller's number from a single call, and the number called from an outbound call
+	"erase-call-ani": `
+  UPDATE calls
+  SET
+    ANI = NULL, ANI_e164 = NULL, ANI_hash = NULL,
+    DNIS = IF(direction = 'outbound', NULL, DNIS), DNIS_e164 = IF(direction = 'outbound', NULL, DNIS_e164), DNIS_hash = NULL,
+    anonymized_at = CURRENT_TIMESTAMP
+  WHERE
+    call_id = ? AND tenant_id = ?
+  `,
+	// clears meta from every event of a single call
+	"erase-call-event-meta": `
+  UPDATE events
+  SET
+    meta = NULL
+  WHERE
+    call_id = ? AND tenant_id = ? AND meta IS NOT NULL
+  `,
+	// clears the blind indexes of the caller and of the party called from the CDR of a single call
+	"erase-cdr-caller": `
+  UPDATE cdrs
+  SET
+    ANI_hash = NULL, DNIS_hash = NULL
+  WHERE
+    call_id = ? AND tenant_id = ?
+  `,
+	// clears the caller of the callback for a single call, cancelling it if still open
+	"erase-callback-caller": `
+  UPDATE callbacks
+  SET
+    ANI_hash = NULL,
+    status = IF(status IN ('pending', 'claimed'), 'cancelled', status)
+  WHERE
+    call_id = ? AND tenant_id = ?
+  `,
+	// deletes the webhook deliveries of a single call, whose payloads carry its events
+	"erase-webhook-deliveries": `
+  DELETE FROM webhook_deliveries
+  WHERE
+    call_id = ? AND tenant_id = ?
+  `,
+	// inserts a new row into the erasure_receipts table
+	"create-erasure-receipt": `
+  INSERT INTO erasure_receipts (tenant_id, ANI_hash, requested_by, reference, calls_erased, events_erased, calls_held)
+    values(?, ?, ?, ?, ?, ?, ?)
+  `,
+	// streams calls created in a time range joined with their events, ordered so that
+	// each call's events are consecutive. Empty status, DNIS or outcome filters match every call.
+	"export-calls": `
+  SELECT
+    c.call_id, c.tenant_id, c.sid, c.conversation_id, c.ANI, c.DNIS, c.ANI_e164, c.DNIS_e164, c.ANI_invalid, c.DNIS_invalid, c.direction, c.block_id, c.block_action, c.DNIS_hash, c.status, c.outcome, c.pii_key_id, c.created_at,
+    e.event_id, e.type, e.identity_id, e.timestamp, e.meta, e.pii_key_id
+  FROM
+    calls c
+    LEFT JOIN events e ON e.call_id = c.call_id AND e.tenant_id = c.tenant_id
+  WHERE
+    c.tenant_id = ? AND c.created_at >= ? AND c.created_at < ? AND c.deleted_at IS NULL
+    AND (? = '' OR c.status = ?)
+    AND (? = '' OR c.DNIS_e164 = ? OR c.DNIS_hash = ?)
+    AND (? = '' OR c.outcome = ?)
+  ORDER BY
+    c.call_id, e.timestamp, e.event_id
+  `,
+	// lists every event of a single call in the order they occurred
+	"list-events-by-call": `
+  SELECT
+    call_id, tenant_id, type, identity_id, timestamp, meta, pii_key_id
+  FROM
+    events
+  WHERE
+    call_id = ? AND tenant_id = ?
+  ORDER BY
+    timestamp, event_id
+  `,
+	// lists the events of a single call up to a timestamp, which a new event is checked against
+	"list-events-by-call-until": `
+  SELECT
+    call_id, tenant_id, type, identity_id, timestamp, meta, pii_key_id
+  FROM
+    events
+  WHERE
+    call_id = ? AND tenant_id = ? AND timestamp <= ?
+  ORDER BY
+    timestamp, event_id
+  `,
+	// locks a call so the events checked against its recorded events are stored one at a time
+	"lock-call": `
+  SELECT
+    call_id
+  FROM
+    calls
+  WHERE
+    call_id = ? AND tenant_id = ? AND deleted_at IS NULL
+  FOR UPDATE
+  `,
+	// gets the blind indexes of the caller and of the party called of a single call, locked
+	// so an erasure cannot clear them while the call detail record is written
+	"get-call-hashes": `
+  SELECT
+    ANI_hash, DNIS_hash, direction
+  FROM
+    calls
+  WHERE
+    call_id = ? AND tenant_id = ?
+  LOCK IN SHARE MODE
+  `,
+	// inserts a call detail record, failing with a duplicate key error when the call already has one
+	"create-cdr": `
+  INSERT INTO cdrs (call_id, tenant_id, conversation_id, DNIS, DNIS_e164, ANI_hash, DNIS_hash, parties,
+    started_at, enqueued_at, ringing_at, connected_at, disconnected_at, dispositioned_at,
+    queue_seconds, ring_seconds, talk_seconds, duration_seconds, queue, disposition, disconnect_cause)
+    values(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+  `,
+	// gets the call detail record of a single call
+	"get-cdr": `
+  SELECT
+    call_id, tenant_id, conversation_id, DNIS, DNIS_e164, ANI_hash, DNIS_hash, parties,
+    started_at, enqueued_at, ringing_at, connected_at, disconnected_at, dispositioned_at,
+    queue_seconds, ring_seconds, talk_seconds, duration_seconds, queue, disposition, disconnect_cause, created_at
+  FROM
+    cdrs
+  WHERE
+    call_id = ? AND tenant_id = ?
+  `,
+	// lists every call of a conversation in the order they were created
+	"list-calls-by-conversation": `
+  SELECT
+    call_id, tenant_id, sid, conversation_id, ANI, DNIS, ANI_e164, DNIS_e164, ANI_invalid, DNIS_invalid, direction, block_id, block_action, DNIS_hash, status, outcome, pii_key_id, created_at
+  FROM
+    calls
+  WHERE
+    tenant_id = ? AND conversation_id = ? AND deleted_at IS NULL
+  ORDER BY
+    created_at, call_id
+  `,
+	// lists every event of every call of a conversation as a single timeline
+	"list-events-by-conversation": `
+  SELECT
+    e.call_id, e.tenant_id, e.type, e.identity_id, e.timestamp, e.meta, e.pii_key_id
+  FROM
+    events e
+    JOIN calls c ON c.call_id = e.call_id AND c.tenant_id = e.tenant_id
+  WHERE
+    c.tenant_id = ? AND c.conversation_id = ? AND c.deleted_at IS NULL
+  ORDER BY
+    e.timestamp, e.event_id
+  `,
+	// moves a single call into a conversation
+	"link-call": `
+  UPDATE calls
+  SET
+    conversation_id = ?
+  WHERE
+    call_id = ? AND tenant_id = ? AND deleted_at IS NULL
+  `,
+	// advances the tenant's conversation id counter past every id its calls use, locking its
+	// row until the tx ends
+	"increment-conversation-id": `
+  INSERT INTO conversation_ids (tenant_id, last_id)
+  SELECT
+    ?, COALESCE(MAX(conversation_id), 0) + 1
+  FROM calls
+  WHERE
+    tenant_id = ?
+  ON DUPLICATE KEY UPDATE
+    last_id = GREATEST(last_id + 1, VALUES(last_id))
+  `,
+	// fetches the conversation id last allocated to the tenant, once incremented
+	"get-conversation-id": `
+  SELECT
+    last_id
+  FROM
+    conversation_ids
+  WHERE
+    tenant_id = ?
+  `,
+	// puts a call that is in no conversation into one, 0 was once written for no conversation
+	"start-conversation": `
+  UPDATE calls
+  SET
+    conversation_id = ?
+  WHERE
+    call_id = ? AND tenant_id = ? AND (conversation_id IS NULL OR conversation_id = 0) AND deleted_at IS NULL
+  `,
+	// removes a single call from the conversation it belongs to
+	"unlink-call": `
+  UPDATE calls
+  SET
+    conversation_id = NULL
+  WHERE
+    call_id = ? AND conversation_id = ? AND tenant_id = ? AND deleted_at IS NULL
+  `,
+	// lists every event of the calls that had an event of a type in a time range
+	"list-calls-with-event": `
+  SELECT
+    call_id, tenant_id, type, identity_id, timestamp, meta, pii_key_id
+  FROM
+    events
+  WHERE
+    tenant_id = ? AND call_id IN (
+      SELECT e.call_id FROM events e JOIN calls c ON c.call_id = e.call_id AND c.tenant_id = e.tenant_id
+      WHERE e.tenant_id = ? AND e.type = ? AND e.timestamp >= ? AND e.timestamp < ? AND c.deleted_at IS NULL
+    )
+  ORDER BY
+    call_id, timestamp, event_id
+  `,
+	// lists every event of the calls an identity had an event on in a time range
+	"list-calls-with-identity": `
+  SELECT
+    call_id, tenant_id, type, identity_id, timestamp, meta, pii_key_id
+  FROM
+    events
+  WHERE
+    tenant_id = ? AND call_id IN (
+      SELECT e.call_id FROM events e JOIN calls c ON c.call_id = e.call_id AND c.tenant_id = e.tenant_id
+      WHERE e.tenant_id = ? AND e.identity_id = ? AND e.timestamp >= ? AND e.timestamp < ? AND c.deleted_at IS NULL
+    )
+  ORDER BY
+    call_id, timestamp, event_id
+  `,
+	// records how a single call ended
+	"set-call-outcome": `
+  UPDATE calls
+  SET
+    outcome = ?
+  WHERE
+    call_id = ? AND tenant_id = ? AND deleted_at IS NULL
+  `,
+	// lists the most recent calls created in a time range. An empty outcome filter matches every call.
+	"list-calls": `
+  SELECT
+    call_id, tenant_id, sid, conversation_id, ANI, DNIS, ANI_e164, DNIS_e164, ANI_invalid, DNIS_invalid, direction, block_id, block_action, DNIS_hash, status, outcome, pii_key_id, created_at
+  FROM
+    calls
+  WHERE
+    tenant_id = ? AND created_at >= ? AND created_at < ? AND deleted_at IS NULL
+    AND (? = '' OR status = ?)
+    AND (? = '' OR DNIS_e164 = ? OR DNIS_hash = ?)
+    AND (? = '' OR outcome = ?)
+  ORDER BY
+    created_at DESC, call_id DESC
+  LIMIT ?
+  `,
+	// schedules a callback for the caller of an inbound call, unless the call has no caller or
+	// the caller already has an open callback
+	"create-callback": `
+  INSERT IGNORE INTO callbacks (tenant_id, call_id, ANI_hash, reason, due_at)
+  SELECT
+    c.tenant_id, c.call_id, c.ANI_hash, ?, ?
+  FROM
+    calls c
+  WHERE
+    c.call_id = ? AND c.tenant_id = ? AND c.direction = 'inbound' AND c.ANI_hash IS NOT NULL AND c.deleted_at IS NULL
+    AND NOT EXISTS (
+      SELECT 1 FROM callbacks cb
+      WHERE cb.tenant_id = c.tenant_id AND cb.ANI_hash = c.ANI_hash AND cb.status IN ('pending', 'claimed')
+    )
+  `,
+	// gets a single callback with the caller of its call
+	"get-callback": `
+  SELECT
+    cb.callback_id, cb.tenant_id, cb.call_id, cb.reason, cb.status, cb.due_at, cb.claimed_by, cb.claimed_at,
+    cb.completed_at, cb.outbound_call_id, cb.created_at, c.ANI, c.ANI_e164, c.DNIS, c.pii_key_id
+  FROM
+    callbacks cb
+    JOIN calls c ON c.call_id = cb.call_id AND c.tenant_id = cb.tenant_id
+  WHERE
+    cb.callback_id = ? AND cb.tenant_id = ?
+  `,
+	// lists callbacks in a status due before a time, soonest first
+	"list-callbacks": `
+  SELECT
+    cb.callback_id, cb.tenant_id, cb.call_id, cb.reason, cb.status, cb.due_at, cb.claimed_by, cb.claimed_at,
+    cb.completed_at, cb.outbound_call_id, cb.created_at, c.ANI, c.ANI_e164, c.DNIS, c.pii_key_id
+  FROM
+    callbacks cb
+    JOIN calls c ON c.call_id = cb.call_id AND c.tenant_id = cb.tenant_id
+  WHERE
+    cb.tenant_id = ? AND cb.status = ? AND cb.due_at < ?
+  ORDER BY
+    cb.due_at, cb.callback_id
+  LIMIT ?
+  `,
+	// assigns a pending callback to an agent
+	"claim-callback": `
+  UPDATE callbacks
+  SET
+    status = 'claimed',
+    claimed_by = ?,
+    claimed_at = ?
+  WHERE
+    callback_id = ? AND tenant_id = ? AND status = 'pending'
+  `,
+	// completes a callback claimed by an agent with the call placed to the caller
+	"complete-callback": `
+  UPDATE callbacks
+  SET
+    status = 'completed',
+    completed_at = ?,
+    outbound_call_id = ?
+  WHERE
+    callback_id = ? AND tenant_id = ? AND status = 'claimed' AND claimed_by = ?
+  `,
+	// lists calls created in a time range that have events but none of the terminal type,
+	// with the time of their last event, oldest first
+	"list-stale-calls": `
+  SELECT
+    c.call_id, MAX(e.timestamp)
+  FROM
+    calls c
+    JOIN events e ON e.call_id = c.call_id AND e.tenant_id = c.tenant_id
+  WHERE
+    c.tenant_id = ? AND c.created_at >= ? AND c.created_at < ? AND c.deleted_at IS NULL
+    AND NOT EXISTS (
+      SELECT 1 FROM events d
+      WHERE d.call_id = c.call_id AND d.tenant_id = c.tenant_id AND d.type = ?
+    )
+  GROUP BY
+    c.call_id, c.created_at
+  ORDER BY
+    c.created_at, c.call_id
+  LIMIT ? OFFSET ?
+  `,
+	// counts the calls created in a time range that have an event of a start type but none
+	// of a terminal type
+	"count-open-calls": `
+  SELECT
+    COUNT(*)
+  FROM
+    calls c
+  WHERE
+    c.tenant_id = ? AND c.created_at >= ? AND c.created_at < ? AND c.deleted_at IS NULL
+    AND EXISTS (
+      SELECT 1 FROM events e WHERE e.call_id = c.call_id AND e.tenant_id = c.tenant_id AND e.type = ?
+    )
+    AND NOT EXISTS (
+      SELECT 1 FROM events d WHERE d.call_id = c.call_id AND d.tenant_id = c.tenant_id AND d.type = ?
+    )
+  `,
+	// lists the normalized DNIS of the calls that had an event of a type in a time range.
+	// Outbound calls list none, their DNIS is the party called rather than a line.
+	"list-dnis-with-event": `
+  SELECT DISTINCT
+    c.call_id, IF(c.direction = 'outbound', NULL, c.DNIS_e164)
+  FROM
+    calls c
+    JOIN events e ON e.call_id = c.call_id AND e.tenant_id = c.tenant_id
+  WHERE
+    c.tenant_id = ? AND e.type = ? AND e.timestamp >= ? AND e.timestamp < ? AND c.deleted_at IS NULL
+  `,
+	// gets the current state of a single agent
+	"get-agent-state": `
+  SELECT
+    tenant_id, identity_id, state, call_id, since
+  FROM
+    agent_states
+  WHERE
+    tenant_id = ? AND identity_id = ?
+  `,
+	// locks the current state of a single agent for a transition
+	"lock-agent-state": `
+  SELECT
+    tenant_id, identity_id, state, call_id, since
+  FROM
+    agent_states
+  WHERE
+    tenant_id = ? AND identity_id = ?
+  FOR UPDATE
+  `,
+	// lists the current state of every agent, optionally in a single state
+	"list-agent-states": `
+  SELECT
+    tenant_id, identity_id, state, call_id, since
+  FROM
+    agent_states
+  WHERE
+    tenant_id = ? AND (? = '' OR state = ?)
+  ORDER BY
+    identity_id
+  `,
+	// lists the agents whose current state concerns a call
+	"list-agent-states-by-call": `
+  SELECT
+    tenant_id, identity_id, state, call_id, since
+  FROM
+    agent_states
+  WHERE
+    tenant_id = ? AND call_id = ?
+  ORDER BY
+    identity_id
+  `,
+	// sets the current state of an agent
+	"upsert-agent-state": `
+  INSERT INTO agent_states (tenant_id, identity_id, state, call_id, since)
+    VALUES (?, ?, ?, ?, ?)
+  ON DUPLICATE KEY UPDATE
+    state = VALUES(state),
+    call_id = VALUES(call_id),
+    since = VALUES(since)
+  `,
+	// records a completed period an agent spent in a state
+	"create-agent-state-period": `
+  INSERT INTO agent_state_history (tenant_id, identity_id, state, call_id, started_at, ended_at)
+    VALUES (?, ?, ?, ?, ?, ?)
+  `,
+	// lists the periods of an agent that overlap a time range, oldest first
+	"list-agent-state-history": `
+  SELECT
+    identity_id, state, call_id, started_at, ended_at
+  FROM
+    agent_state_history
+  WHERE
+    tenant_id = ? AND identity_id = ? AND started_at < ? AND ended_at > ?
+  ORDER BY
+    started_at, period_id
+  `,
+	// inserts a new row into the webhook_subscriptions table
+	"create-webhook-subscription": `
+  INSERT INTO webhook_subscriptions (tenant_id, url, event_types, DNIS_e164, secret, pii_key_id, paused)
+  VALUES (?, ?, ?, ?, ?, ?, ?)
+  `,
+	// gets a single webhook subscription
+	"get-webhook-subscription": `
+  SELECT
+    subscription_id, tenant_id, url, event_types, DNIS_e164, secret, pii_key_id, paused, created_at, updated_at
+  FROM
+    webhook_subscriptions
+  WHERE
+    subscription_id = ? AND tenant_id = ? AND deleted_at IS NULL
+  `,
+	// lists every webhook subscription of a tenant, oldest first
+	"list-webhook-subscriptions": `
+  SELECT
+    subscription_id, tenant_id, url, event_types, DNIS_e164, secret, pii_key_id, paused, created_at, updated_at
+  FROM
+    webhook_subscriptions
+  WHERE
+    tenant_id = ? AND deleted_at IS NULL
+  ORDER BY
+    subscription_id
+  `,
+	// updates a single webhook subscription
+	"update-webhook-subscription": `
+  UPDATE webhook_subscriptions
+  SET
+    url = ?,
+    event_types = ?,
+    DNIS_e164 = ?,
+    secret = ?,
+    pii_key_id = ?,
+    paused = ?
+  WHERE
+    subscription_id = ? AND tenant_id = ? AND deleted_at IS NULL
+  `,
+	// sets deleted_at for a single webhook subscription
+	"delete-webhook-subscription": `
+  UPDATE webhook_subscriptions
+  SET
+    deleted_at = CURRENT_TIMESTAMP
+  WHERE
+    subscription_id = ? AND tenant_id = ? AND deleted_at IS NULL
+  `,
+	// cancels the deliveries of a webhook subscription that are still to be attempted
+	"cancel-webhook-deliveries": `
+  UPDATE webhook_deliveries
+  SET
+    status = 'cancelled'
+  WHERE
+    subscription_id = ? AND tenant_id = ? AND status = 'pending'
+  `,
+	// inserts a new row into the webhook_deliveries table
+	"create-webhook-delivery": `
+  INSERT INTO webhook_deliveries (tenant_id, subscription_id, call_id, event_type, payload, pii_key_id, next_attempt_at)
+  VALUES (?, ?, ?, ?, ?, ?, ?)
+  `,
+	// gets a single webhook delivery of a subscription
+	"get-webhook-delivery": `
+  SELECT
+    delivery_id, tenant_id, subscription_id, call_id, event_type, payload, pii_key_id, status, attempts,
+    next_attempt_at, last_status_code, last_error, delivered_at, created_at
+  FROM
+    webhook_deliveries
+  WHERE
+    delivery_id = ? AND subscription_id = ? AND tenant_id = ?
+  `,
+	// lists the deliveries of a subscription, optionally in a single status, newest first
+	"list-webhook-deliveries": `
+  SELECT
+    delivery_id, tenant_id, subscription_id, call_id, event_type, payload, pii_key_id, status, attempts,
+    next_attempt_at, last_status_code, last_error, delivered_at, created_at
+  FROM
+    webhook_deliveries
+  WHERE
+    tenant_id = ? AND subscription_id = ? AND (? = '' OR status = ?)
+  ORDER BY
+    delivery_id DESC
+  LIMIT ?
+  `,
+	// lists the pending deliveries due for an attempt to subscriptions that are not paused, oldest first
+	"list-due-webhook-deliveries": `
+  SELECT
+    d.delivery_id, d.tenant_id, d.subscription_id, d.call_id, d.event_type, d.payload, d.pii_key_id, d.status, d.attempts,
+    d.next_attempt_at, d.last_status_code, d.last_error, d.delivered_at, d.created_at
+  FROM
+    webhook_deliveries d
+    JOIN webhook_subscriptions s ON s.subscription_id = d.subscription_id AND s.tenant_id = d.tenant_id
+  WHERE
+    d.tenant_id = ? AND d.status = 'pending' AND d.next_attempt_at <= ? AND NOT s.paused
+  ORDER BY
+    d.next_attempt_at, d.delivery_id
+  LIMIT ?
+  `,
+	// leases a pending delivery for an attempt, unless another worker leased it first
+	"claim-webhook-delivery": `
+  UPDATE webhook_deliveries
+  SET
+    next_attempt_at = ?
+  WHERE
+    delivery_id = ? AND tenant_id = ? AND status = 'pending' AND next_attempt_at = ? AND attempts = ?
+  `,
+	// records the result of an attempt to deliver a webhook
+	"record-webhook-attempt": `
+  UPDATE webhook_deliveries
+  SET
+    status = ?,
+    attempts = ?,
+    next_attempt_at = ?,
+    last_status_code = ?,
+    last_error = ?,
+    delivered_at = ?
+  WHERE
+    delivery_id = ? AND tenant_id = ?
+  `,
+	// requeues a dead delivery for a fresh round of attempts
+	"redeliver-webhook": `
+  UPDATE webhook_deliveries
+  SET
+    status = 'pending',
+    attempts = 0,
+    next_attempt_at = ?
+  WHERE
+    delivery_id = ? AND subscription_id = ? AND tenant_id = ? AND status = 'dead'
+  `,
+	// inserts a number block, or updates the action and reason of the entry already
+	// blocking the same number or prefix, leaving its id as the last insert id
+	"create-number-block": `
+  INSERT INTO number_blocks (tenant_id, list, number, number_hash, prefix, action, reason, pii_key_id)
+  VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+  ON DUPLICATE KEY UPDATE
+    block_id = LAST_INSERT_ID(block_id), action = VALUES(action), reason = VALUES(reason)
+  `,
+	// fetches a single number block by id
+	"get-number-block": `
+  SELECT
+    block_id, tenant_id, list, number, prefix, action, reason, pii_key_id, created_at
+  FROM
+    number_blocks
+  WHERE
+    block_id = ? AND tenant_id = ?
+  `,
+	// lists the most recent number blocks, optionally of one list
+	"list-number-blocks": `
+  SELECT
+    block_id, tenant_id, list, number, prefix, action, reason, pii_key_id, created_at
+  FROM
+    number_blocks
+  WHERE
+    tenant_id = ? AND (? = '' OR list = ?)
+  ORDER BY
+    block_id DESC
+  LIMIT ?
+  `,
+	// deletes a single number block by id, blocked calls keep referring to it
+	"delete-number-block": `
+  DELETE FROM number_blocks
+  WHERE
+    block_id = ? AND tenant_id = ?
+  `,
+	// finds the entries of a list blocking a number exactly, by its blind index, or by prefix
+	"match-number-blocks": `
+  SELECT
+    block_id, tenant_id, list, number, prefix, action, reason, pii_key_id, created_at
+  FROM
+    number_blocks
+  WHERE
+    tenant_id = ? AND list = ? AND (number_hash = ? OR (prefix IS NOT NULL AND ? LIKE CONCAT(prefix, '%')))
+  `,
+	// records a call rejected or flagged by a number block
+	"create-blocked-call": `
+  INSERT INTO blocked_calls (tenant_id, call_id, block_id, list, action, number_hash)
+  VALUES (?, ?, ?, ?, ?, ?)
+  `,
+	// lists the most recent blocked calls in a time range, optionally of one list
+	"list-blocked-calls": `
+  SELECT
+    blocked_call_id, tenant_id, call_id, block_id, list, action, created_at
+  FROM
+    blocked_calls
+  WHERE
+    tenant_id = ? AND created_at >= ? AND created_at < ? AND (? = '' OR list = ?)
+  ORDER BY
+    blocked_call_id DESC
+  LIMIT ?
+  `,
+	// increments the tenant's rule set version counter, locking its row until the tx ends
+	"increment-routing-rule-version": `
+  INSERT INTO routing_rule_versions (tenant_id, version)
+    values(?, 1)
+  ON DUPLICATE KEY UPDATE
+    version = version + 1
+  `,
+	// fetches the version the tenant's next rule set is saved as, once incremented
+	"get-routing-rule-version": `
+  SELECT
+    version
+  FROM
+    routing_rule_versions
+  WHERE
+    tenant_id = ?
+  `,
+	// inserts a new version of the tenant's rules
+	"create-routing-rule-set": `
+  INSERT INTO routing_rule_sets (tenant_id, version, rules, created_by)
+  VALUES (?, ?, ?, ?)
+  `,
+	// fetches a single version of the tenant's rules
+	"get-routing-rule-set": `
+  SELECT
+    tenant_id, version, rules, created_by, created_at
+  FROM
+    routing_rule_sets
+  WHERE
+    tenant_id = ? AND version = ?
+  `,
+	// fetches the active, most recent, version of the tenant's rules
+	"get-active-routing-rule-set": `
+  SELECT
+    tenant_id, version, rules, created_by, created_at
+  FROM
+    routing_rule_sets
+  WHERE
+    tenant_id = ?
+  ORDER BY
+    version DESC
+  LIMIT 1
+  `,
+	// lists the most recent versions of the tenant's rules
+	"list-routing-rule-sets": `
+  SELECT
+    tenant_id, version, rules, created_by, created_at
+  FROM
+    routing_rule_sets
+  WHERE
+    tenant_id = ?
+  ORDER BY
+    version DESC
+  LIMIT ?
+  `,
 }
@@ -3,8 +3,11 @@ package db
 import (
 	"context"
 	"database/sql"
+	"sort"
 
 	"github.com/caring/go-packages/pkg/errors"
+
+	"github.com/caring/call-handling/internal/fieldcrypt"
 	_ "github.com/caring/go-packages/pkg/uuid"
 
 	// anonymous import so package exports are not exposed
@@ -19,15 +22,26 @@ var txCtxKey = ctxKey{}
 // of statements that we will use to interface with
 // a backing store
 type Store struct {
-	Calls  *callService
-	Events *eventService
+	Calls         *callService
+	Events        *eventService
+	Tenants       *tenantService
+	Retention     *retentionService
+	Erasures      *erasureService
+	CDRs          *cdrService
+	Conversations *conversationService
+	Callbacks     *callbackService
+	Agents        *agentService
+	Webhooks      *webhookService
+	Blocks        *blockService
+	Routing       *routingService
 
 	db    *sql.DB
 	stmts map[string]*sql.Stmt
 }
 
 // NewStore will give a pointer to a MySQL instance ready to run queries against.
-func NewStore(dataSourceName string) (*Store, error) {
+// PII columns are encrypted and decrypted with enc.
+func NewStore(dataSourceName string, enc *fieldcrypt.Encrypter) (*Store, error) {
 	unprepared := statements
 
 	db, err := sql.Open("mysql", dataSourceName)
@@ -46,22 +60,32 @@ func NewStore(dataSourceName string) (*Store, error) {
 	}
 
 	s := Store{
-		db:     db,
-		stmts:  stmts,
-		Calls:  &callService{db, stmts},
-		Events: &eventService{db, stmts},
+		db:            db,
+		stmts:         stmts,
+		Calls:         &callService{db, stmts, enc},
+		Events:        &eventService{db, stmts, enc},
+		Tenants:       &tenantService{db, stmts},
+		Retention:     &retentionService{db, stmts},
+		Erasures:      &erasureService{db, stmts, enc},
+		CDRs:          &cdrService{db, stmts},
+		Conversations: &conversationService{db, stmts, enc},
+		Agents:        &agentService{db, stmts},
+		Webhooks:      &webhookService{db, stmts, enc},
+		Blocks:        &blockService{db, stmts, enc},
+		Routing:       &routingService{db, stmts},
 	}
+	s.Callbacks = &callbackService{db, stmts, enc, s.Calls}
 
 	return &s, nil
 }
 
 // prepareStmts will attempt to prepare each unprepared
-// query on the database. If one fails, the function returns
+// query on the database in name order. If one fails, the function returns
 // with an error.
 func prepareStmts(db *sql.DB, unprepared map[string]string) (map[string]*sql.Stmt, error) {
 	prepared := map[string]*sql.Stmt{}
-	for k, v := range unprepared {
-		stmt, err := db.Prepare(v)
+	for _, k := range stmtNames(unprepared) {
+		stmt, err := db.Prepare(unprepared[k])
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
@@ -70,6 +94,16 @@ func prepareStmts(db *sql.DB, unprepared map[string]string) (map[string]*sql.Stm
 	return prepared, nil
 }
 
+// stmtNames returns the names of unprepared statements in sorted order
+func stmtNames(unprepared map[string]string) []string {
+	names := make([]string, 0, len(unprepared))
+	for k := range unprepared {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // Close will close the connection to the underlying database
 func (s *Store) Close() error {
 	err := s.db.Close()
@@ -110,3 +144,13 @@ func FromCtx(ctx context.Context) (*sql.Tx, error) {
 	}
 	return nil, errors.New("No *sql.Tx present in context")
 }
+
+// nullString maps an empty string to a SQL NULL
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+// nullInt64 maps a zero id to a SQL NULL
+func nullInt64(i int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: i, Valid: i != 0}
+}
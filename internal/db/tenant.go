@@ -0,0 +1,160 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+type tenantCtxKey struct{}
+
+var tenantKey = tenantCtxKey{}
+
+// tenantService provides an API for interacting with the tenants table
+type tenantService struct {
+	db    *sql.DB
+	stmts map[string]*sql.Stmt
+}
+
+// Tenant is a struct representation of a row in the tenants table
+type Tenant struct {
+	ID     string
+	Name   string
+	Config TenantConfig
+}
+
+// TenantConfig holds the per-tenant settings stored in the config column
+type TenantConfig struct {
+	// Timezone is the IANA zone the tenant operates in, defaults to UTC
+	Timezone string `json:"timezone,omitempty"`
+	// DefaultRegion is the ISO 3166-1 alpha-2 region national phone numbers are parsed in
+	DefaultRegion string `json:"default_region,omitempty"`
+	// RejectInvalidNumbers fails call creation on unparseable numbers instead of flagging them
+	RejectInvalidNumbers bool `json:"reject_invalid_numbers,omitempty"`
+	// ShortAbandonSeconds is the wait under which an unanswered call is a short abandon rather
+	// than a real one, defaults to 10 and a negative value disables short abandons
+	ShortAbandonSeconds int `json:"short_abandon_seconds,omitempty"`
+	// Retention is how long each class of call data is kept
+	Retention RetentionConfig `json:"retention,omitempty"`
+	// BusinessHours are when the tenant's agents work, unset means always
+	BusinessHours *BusinessHours `json:"business_hours,omitempty"`
+	// MaxCallMinutes is how long a call may go without a disconnect before it is reaped,
+	// 0 uses the service default
+	MaxCallMinutes int `json:"max_call_minutes,omitempty"`
+	// CallbackDelayMinutes is how long after a caller abandons their callback falls due
+	CallbackDelayMinutes int `json:"callback_delay_minutes,omitempty"`
+	// ServiceLevels are the answer time targets queued calls are reported against, the most
+	// specific target matching a call's queue and DNIS applies
+	ServiceLevels []ServiceLevelTarget `json:"service_levels,omitempty"`
+}
+
+// ServiceLevelTarget is a promise to answer a share of calls within a wait
+type ServiceLevelTarget struct {
+	// Queue and DNIS restrict the calls the target applies to, empty matches any
+	Queue string `json:"queue,omitempty"`
+	DNIS  string `json:"dnis,omitempty"`
+	// AnswerSeconds is the longest wait a call may have to count as answered within target
+	AnswerSeconds int `json:"answer_seconds"`
+	// Percent is the share of calls to answer within AnswerSeconds, from 0 to 100
+	Percent float64 `json:"percent"`
+}
+
+// BusinessHours are the daily opening hours of a tenant in its Timezone
+type BusinessHours struct {
+	// Open and Close are local times of day as HH:MM, Close is exclusive
+	Open  string `json:"open"`
+	Close string `json:"close"`
+	// Days are the three letter names of the days open, defaults to Mon through Fri
+	Days []string `json:"days,omitempty"`
+}
+
+// RetentionConfig holds the number of days each class of data is kept, 0 keeps it forever
+type RetentionConfig struct {
+	// EventsDays deletes event rows
+	EventsDays int `json:"events_days,omitempty"`
+	// MetaDays clears provider metadata from events that are otherwise kept
+	MetaDays int `json:"meta_days,omitempty"`
+	// ANIDays clears the caller's number from calls that are otherwise kept
+	ANIDays int `json:"ani_days,omitempty"`
+}
+
+// Get fetches a single tenant from the db
+func (svc *tenantService) Get(ctx context.Context, ID string) (*Tenant, error) {
+	errMsg := func() string { return "Error executing get tenant - " + fmt.Sprint(ID) }
+
+	p, err := scanTenant(svc.stmts["get-tenant"].QueryRowContext(ctx, ID))
+	if err != nil {
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrap(ErrNotFound, errMsg())
+		}
+
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return p, nil
+}
+
+// List fetches every active tenant, for jobs that run on behalf of all tenants
+func (svc *tenantService) List(ctx context.Context) ([]*Tenant, error) {
+	errMsg := func() string { return "Error executing list tenants" }
+
+	rows, err := svc.stmts["list-tenants"].QueryContext(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+	defer rows.Close()
+
+	tenants := []*Tenant{}
+	for rows.Next() {
+		p, err := scanTenant(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errMsg())
+		}
+		tenants = append(tenants, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return tenants, nil
+}
+
+// scanTenant reads a tenants row and decodes its config
+func scanTenant(row rowScanner) (*Tenant, error) {
+	var (
+		p   = Tenant{}
+		raw sql.NullString
+	)
+
+	if err := row.Scan(&p.ID, &p.Name, &raw); err != nil {
+		return nil, err
+	}
+
+	if raw.Valid && raw.String != "" {
+		if err := json.Unmarshal([]byte(raw.String), &p.Config); err != nil {
+			return nil, err
+		}
+	}
+
+	return &p, nil
+}
+
+// TenantToCtx stores the tenant a request is acting on behalf of within a context
+func TenantToCtx(ctx context.Context, t *Tenant) context.Context {
+	return context.WithValue(ctx, tenantKey, t)
+}
+
+// TenantFromCtx extracts the tenant stored by TenantToCtx, returns ErrNoTenant
+// if the context was never scoped to a tenant
+func TenantFromCtx(ctx context.Context) (*Tenant, error) {
+	val, ok := ctx.Value(tenantKey).(*Tenant)
+	if !ok || val == nil || val.ID == "" {
+		return nil, errors.WithStack(ErrNoTenant)
+	}
+	return val, nil
+}
@@ -0,0 +1,538 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/caring/go-packages/pkg/errors"
+
+	"github.com/caring/call-handling/internal/fieldcrypt"
+	"github.com/caring/call-handling/pb"
+)
+
+// webhookService provides an API for interacting with the webhook_subscriptions and
+// webhook_deliveries tables
+type webhookService struct {
+	db    *sql.DB
+	stmts map[string]*sql.Stmt
+	enc   *fieldcrypt.Encrypter
+}
+
+// WebhookSubscription is a struct representation of a row in the webhook_subscriptions table
+type WebhookSubscription struct {
+	ID       int64
+	TenantID string
+	URL      string
+	// EventTypes and DNISE164 restrict the events delivered, empty delivers every one
+	EventTypes []string
+	DNISE164   []string
+	// Secret is the key payloads are signed with, it is never returned to clients
+	Secret    string
+	Paused    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ToProto casts a db webhook subscription into a proto response object
+func (m *WebhookSubscription) ToProto() *pb.WebhookSubscription {
+	return &pb.WebhookSubscription{
+		SubscriptionId: m.ID,
+		Url:            m.URL,
+		EventTypes:     m.EventTypes,
+		DNISE164:       m.DNISE164,
+		Paused:         m.Paused,
+		CreatedAt:      m.CreatedAt.Unix(),
+		UpdatedAt:      m.UpdatedAt.Unix(),
+	}
+}
+
+// String keeps the secret out of logs and errors
+func (m *WebhookSubscription) String() string {
+	return fmt.Sprintf("&{%d, %s, %s, %v, %v, [secret], %t}", m.ID, m.TenantID, m.URL, m.EventTypes, m.DNISE164, m.Paused)
+}
+
+// GoString keeps %#v from bypassing String
+func (m *WebhookSubscription) GoString() string {
+	return m.String()
+}
+
+// WebhookDelivery is a struct representation of a row in the webhook_deliveries table.
+// Times other than CreatedAt are unix seconds and are 0 when unset.
+type WebhookDelivery struct {
+	ID             int64
+	TenantID       string
+	SubscriptionID int64
+	CallID         int64
+	EventType      string
+	// Payload is the JSON body posted to the subscription
+	Payload string
+	Status  string
+	// Attempts is the number of attempts made, NextAttemptAt is when the next is due
+	Attempts       int
+	NextAttemptAt  int64
+	LastStatusCode int
+	LastError      string
+	DeliveredAt    int64
+	CreatedAt      time.Time
+}
+
+// ToProto casts a db webhook delivery into a proto response object, without its payload
+func (m *WebhookDelivery) ToProto() *pb.WebhookDelivery {
+	return &pb.WebhookDelivery{
+		DeliveryId:     m.ID,
+		SubscriptionId: m.SubscriptionID,
+		CallId:         m.CallID,
+		EventType:      m.EventType,
+		Status:         m.Status,
+		Attempts:       int32(m.Attempts),
+		NextAttemptAt:  m.NextAttemptAt,
+		LastStatusCode: int32(m.LastStatusCode),
+		LastError:      m.LastError,
+		DeliveredAt:    m.DeliveredAt,
+		CreatedAt:      m.CreatedAt.Unix(),
+	}
+}
+
+// String keeps the payload, which carries event meta, out of logs and errors
+func (m *WebhookDelivery) String() string {
+	return fmt.Sprintf("&{%d, %s, %d, %d, %s, [payload], %s, %d, %d}",
+		m.ID, m.TenantID, m.SubscriptionID, m.CallID, m.EventType, m.Status, m.Attempts, m.NextAttemptAt)
+}
+
+// GoString keeps %#v from bypassing String
+func (m *WebhookDelivery) GoString() string {
+	return m.String()
+}
+
+// WebhookAttempt is the result of an attempt to deliver a webhook
+type WebhookAttempt struct {
+	Status        string
+	NextAttemptAt int64
+	StatusCode    int
+	Error         string
+	DeliveredAt   int64
+}
+
+// Create inserts a new webhook subscription, setting its ID
+func (svc *webhookService) Create(ctx context.Context, input *WebhookSubscription) error {
+	errMsg := func() string { return "Error executing create webhook subscription - " + fmt.Sprint(input) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+	input.TenantID = tenant.ID
+
+	eventTypes, dnis, secret, err := svc.seal(ctx, input)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	result, err := svc.stmts["create-webhook-subscription"].ExecContext(ctx,
+		input.TenantID, input.URL, eventTypes, dnis, secret, svc.enc.CurrentKeyID(), input.Paused)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	if input.ID, err = result.LastInsertId(); err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	return nil
+}
+
+// Get fetches a single webhook subscription
+func (svc *webhookService) Get(ctx context.Context, ID int64) (*WebhookSubscription, error) {
+	errMsg := func() string { return "Error executing get webhook subscription - " + fmt.Sprint(ID) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	p, err := svc.scanSubscription(ctx, svc.stmts["get-webhook-subscription"].QueryRowContext(ctx, ID, tenant.ID))
+	if err != nil {
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrap(ErrNotFound, errMsg())
+		}
+
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return p, nil
+}
+
+// List fetches every webhook subscription of the tenant, paused or not
+func (svc *webhookService) List(ctx context.Context) ([]*WebhookSubscription, error) {
+	errMsg := func() string { return "Error executing list webhook subscriptions" }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	rows, err := svc.stmts["list-webhook-subscriptions"].QueryContext(ctx, tenant.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+	defer rows.Close()
+
+	subscriptions := []*WebhookSubscription{}
+	for rows.Next() {
+		p, err := svc.scanSubscription(ctx, rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errMsg())
+		}
+		subscriptions = append(subscriptions, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return subscriptions, nil
+}
+
+// Update replaces a single webhook subscription
+func (svc *webhookService) Update(ctx context.Context, input *WebhookSubscription) error {
+	errMsg := func() string { return "Error executing update webhook subscription - " + fmt.Sprint(input) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+	input.TenantID = tenant.ID
+
+	eventTypes, dnis, secret, err := svc.seal(ctx, input)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	// the secret is sealed afresh, so the row changes even when nothing else does
+	result, err := svc.stmts["update-webhook-subscription"].ExecContext(ctx,
+		input.URL, eventTypes, dnis, secret, svc.enc.CurrentKeyID(), input.Paused, input.ID, input.TenantID)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	rowCount, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	if rowCount == 0 {
+		return errors.Wrap(ErrNoRowsAffected, errMsg())
+	}
+
+	return nil
+}
+
+// Delete sets deleted_at for a single webhook subscription and cancels its pending
+// deliveries in a single transaction
+func (svc *webhookService) Delete(ctx context.Context, ID int64) error {
+	errMsg := func() string { return "Error executing delete webhook subscription - " + fmt.Sprint(ID) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	result, err := tx.Stmt(svc.stmts["delete-webhook-subscription"]).ExecContext(ctx, ID, tenant.ID)
+	if err != nil {
+		tx.Rollback()
+		return errors.Wrap(err, errMsg())
+	}
+
+	rowCount, err := result.RowsAffected()
+	if err != nil {
+		tx.Rollback()
+		return errors.Wrap(err, errMsg())
+	}
+
+	if rowCount == 0 {
+		tx.Rollback()
+		return errors.Wrap(ErrNoRowsAffected, errMsg())
+	}
+
+	if _, err = tx.Stmt(svc.stmts["cancel-webhook-deliveries"]).ExecContext(ctx, ID, tenant.ID); err != nil {
+		tx.Rollback()
+		return errors.Wrap(err, errMsg())
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	return nil
+}
+
+// seal encodes a subscription's filters and encrypts its secret
+func (svc *webhookService) seal(ctx context.Context, input *WebhookSubscription) (eventTypes, dnis []byte, secret string, err error) {
+	if eventTypes, err = json.Marshal(nonNil(input.EventTypes)); err != nil {
+		return nil, nil, "", err
+	}
+	if dnis, err = json.Marshal(nonNil(input.DNISE164)); err != nil {
+		return nil, nil, "", err
+	}
+	if secret, err = svc.enc.Encrypt(ctx, input.Secret); err != nil {
+		return nil, nil, "", err
+	}
+	return eventTypes, dnis, secret, nil
+}
+
+// scanSubscription reads a webhook_subscriptions row, decoding its filters and decrypting its secret
+func (svc *webhookService) scanSubscription(ctx context.Context, row rowScanner) (*WebhookSubscription, error) {
+	var (
+		p                = WebhookSubscription{}
+		eventTypes, dnis []byte
+		keyID            sql.NullString
+	)
+
+	err := row.Scan(&p.ID, &p.TenantID, &p.URL, &eventTypes, &dnis, &p.Secret, &keyID, &p.Paused, &p.CreatedAt, &p.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(eventTypes, &p.EventTypes); err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(dnis, &p.DNISE164); err != nil {
+		return nil, err
+	}
+	if p.Secret, err = openPII(ctx, svc.enc, keyID, p.Secret); err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// CreateDeliveries queues webhook deliveries in a single transaction
+func (svc *webhookService) CreateDeliveries(ctx context.Context, input []*WebhookDelivery) error {
+	errMsg := func() string { return "Error executing create webhook deliveries - " + fmt.Sprint(len(input)) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	stmt := tx.Stmt(svc.stmts["create-webhook-delivery"])
+	for _, d := range input {
+		d.TenantID = tenant.ID
+
+		payload, err := svc.enc.Encrypt(ctx, d.Payload)
+		if err != nil {
+			tx.Rollback()
+			return errors.Wrap(err, errMsg())
+		}
+
+		result, err := stmt.ExecContext(ctx, d.TenantID, d.SubscriptionID, d.CallID, d.EventType, payload,
+			svc.enc.CurrentKeyID(), d.NextAttemptAt)
+		if err != nil {
+			tx.Rollback()
+			return errors.Wrap(err, errMsg())
+		}
+
+		if d.ID, err = result.LastInsertId(); err != nil {
+			tx.Rollback()
+			return errors.Wrap(err, errMsg())
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	return nil
+}
+
+// GetDelivery fetches a single delivery of a subscription
+func (svc *webhookService) GetDelivery(ctx context.Context, subscriptionID, ID int64) (*WebhookDelivery, error) {
+	errMsg := func() string { return "Error executing get webhook delivery - " + fmt.Sprint(ID) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	p, err := svc.scanDelivery(ctx, svc.stmts["get-webhook-delivery"].QueryRowContext(ctx, ID, subscriptionID, tenant.ID))
+	if err != nil {
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrap(ErrNotFound, errMsg())
+		}
+
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return p, nil
+}
+
+// ListDeliveries fetches the most recent deliveries of a subscription, or of those in
+// status if it is set
+func (svc *webhookService) ListDeliveries(ctx context.Context, subscriptionID int64, status string, limit int) ([]*WebhookDelivery, error) {
+	errMsg := func() string { return "Error executing list webhook deliveries - " + fmt.Sprint(subscriptionID) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return svc.listDeliveries(ctx, errMsg, "list-webhook-deliveries", tenant.ID, subscriptionID, status, status, limit)
+}
+
+// ListDue fetches the pending deliveries due for an attempt at now, oldest first. Deliveries
+// to paused subscriptions are left out until the subscription resumes.
+func (svc *webhookService) ListDue(ctx context.Context, now int64, limit int) ([]*WebhookDelivery, error) {
+	errMsg := func() string { return "Error executing list due webhook deliveries - " + fmt.Sprint(now) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return svc.listDeliveries(ctx, errMsg, "list-due-webhook-deliveries", tenant.ID, now, limit)
+}
+
+// listDeliveries runs a statement selecting webhook deliveries
+func (svc *webhookService) listDeliveries(ctx context.Context, errMsg func() string, stmt string, args ...interface{}) ([]*WebhookDelivery, error) {
+	rows, err := svc.stmts[stmt].QueryContext(ctx, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+	defer rows.Close()
+
+	deliveries := []*WebhookDelivery{}
+	for rows.Next() {
+		p, err := svc.scanDelivery(ctx, rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errMsg())
+		}
+		deliveries = append(deliveries, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errMsg())
+	}
+
+	return deliveries, nil
+}
+
+// Claim leases a due delivery until leaseUntil, so concurrent workers do not attempt it
+// twice. It fails with ErrNoRowsAffected when the delivery changed since it was read.
+func (svc *webhookService) Claim(ctx context.Context, d *WebhookDelivery, leaseUntil int64) error {
+	errMsg := func() string { return "Error executing claim webhook delivery - " + fmt.Sprint(d.ID) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	result, err := svc.stmts["claim-webhook-delivery"].ExecContext(ctx, leaseUntil, d.ID, tenant.ID, d.NextAttemptAt, d.Attempts)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	rowCount, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	if rowCount == 0 {
+		return errors.Wrap(ErrNoRowsAffected, errMsg())
+	}
+
+	d.NextAttemptAt = leaseUntil
+	return nil
+}
+
+// RecordAttempt stores the result of an attempt to deliver a webhook, counting the attempt
+func (svc *webhookService) RecordAttempt(ctx context.Context, d *WebhookDelivery, attempt WebhookAttempt) error {
+	errMsg := func() string { return "Error executing record webhook attempt - " + fmt.Sprint(d.ID) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	_, err = svc.stmts["record-webhook-attempt"].ExecContext(ctx, attempt.Status, d.Attempts+1, attempt.NextAttemptAt,
+		sql.NullInt64{Int64: int64(attempt.StatusCode), Valid: attempt.StatusCode != 0}, nullString(attempt.Error),
+		nullInt64(attempt.DeliveredAt), d.ID, tenant.ID)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	d.Status, d.Attempts, d.NextAttemptAt = attempt.Status, d.Attempts+1, attempt.NextAttemptAt
+	d.LastStatusCode, d.LastError, d.DeliveredAt = attempt.StatusCode, attempt.Error, attempt.DeliveredAt
+	return nil
+}
+
+// Redeliver requeues a dead delivery of a subscription for a fresh round of attempts from now.
+// It fails with ErrNoRowsAffected when the delivery is not dead.
+func (svc *webhookService) Redeliver(ctx context.Context, subscriptionID, ID, now int64) error {
+	errMsg := func() string { return "Error executing redeliver webhook - " + fmt.Sprint(ID) }
+
+	tenant, err := TenantFromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	result, err := svc.stmts["redeliver-webhook"].ExecContext(ctx, now, ID, subscriptionID, tenant.ID)
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	rowCount, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, errMsg())
+	}
+
+	if rowCount == 0 {
+		return errors.Wrap(ErrNoRowsAffected, errMsg())
+	}
+
+	return nil
+}
+
+// scanDelivery reads a webhook_deliveries row, decrypting its payload
+func (svc *webhookService) scanDelivery(ctx context.Context, row rowScanner) (*WebhookDelivery, error) {
+	var (
+		p                       = WebhookDelivery{}
+		keyID, lastError        sql.NullString
+		statusCode, deliveredAt sql.NullInt64
+	)
+
+	err := row.Scan(&p.ID, &p.TenantID, &p.SubscriptionID, &p.CallID, &p.EventType, &p.Payload, &keyID, &p.Status,
+		&p.Attempts, &p.NextAttemptAt, &statusCode, &lastError, &deliveredAt, &p.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	p.LastStatusCode, p.LastError, p.DeliveredAt = int(statusCode.Int64), lastError.String, deliveredAt.Int64
+
+	if p.Payload, err = openPII(ctx, svc.enc, keyID, p.Payload); err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// nonNil keeps an unset list from being encoded as null
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
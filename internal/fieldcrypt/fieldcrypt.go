@@ -0,0 +1,284 @@
+// Package fieldcrypt provides envelope encryption for individual PII columns.
+//
+// Each value is encrypted with AES-256-GCM under a data key (DEK). The DEK is in turn
+// wrapped by a master key held by a KeyProvider, and the wrapped DEK travels with the
+// ciphertext so any row can be decrypted as long as its master key is still available.
+// Rotating the master key only requires re-encrypting rows whose KeyID is not current.
+package fieldcrypt
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+const (
+	// version prefixes every envelope so the format can evolve
+	version = "v1"
+	// dekTTL is how long a generated data key is reused before a new one is requested
+	dekTTL = time.Hour
+	// maxCachedDEKs bounds the number of unwrapped data keys kept for decryption
+	maxCachedDEKs = 1024
+)
+
+var (
+	// ErrMalformed occurs when a value is not an envelope produced by this package
+	ErrMalformed = errors.New("malformed encrypted value")
+	// ErrUnknownKey occurs when a key provider does not hold the requested master key
+	ErrUnknownKey = errors.New("unknown master key")
+	// ErrRevokedKey occurs when a key that has been made public is used to protect new data
+	ErrRevokedKey = errors.New("revoked key")
+)
+
+// revokedKeys are the SHA-256 fingerprints of keys that were once committed to the repository
+// as dev defaults. A revoked master key may still open old values during a rotation but never
+// seals new ones, and a revoked blind index key is refused outright.
+var revokedKeys = map[string]bool{
+	"41eb7a1e1ae89070b7f2bb58eec425a24210897a5f4b905ccaa305f65789cc94": true,
+	"83f21ee4427dc442a1f17539a95758a90b2bb03279326ff692a4554e716f8395": true,
+}
+
+// revoked reports whether key is one of revokedKeys
+func revoked(key []byte) bool {
+	sum := sha256.Sum256(key)
+	return revokedKeys[hex.EncodeToString(sum[:])]
+}
+
+var b64 = base64.RawURLEncoding
+
+// KeyProvider wraps and unwraps data keys with a master key
+type KeyProvider interface {
+	// CurrentKeyID identifies the master key new data keys are wrapped with
+	CurrentKeyID() string
+	// GenerateDataKey returns a new 256 bit data key in plaintext and wrapped form
+	GenerateDataKey(ctx context.Context) (plaintext, wrapped []byte, keyID string, err error)
+	// DecryptDataKey unwraps a data key wrapped by the master key keyID
+	DecryptDataKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error)
+}
+
+// Encrypter encrypts and decrypts column values and computes their blind index
+type Encrypter struct {
+	provider KeyProvider
+	indexKey []byte
+
+	mu        sync.Mutex
+	current   *dataKey
+	unwrapped map[string][]byte
+}
+
+type dataKey struct {
+	plaintext []byte
+	wrapped   []byte
+	keyID     string
+	expires   time.Time
+}
+
+// NewEncrypter creates an Encrypter. indexKey is the HMAC key used for blind indexes
+// and, unlike master keys, cannot be rotated without recomputing every index.
+func NewEncrypter(provider KeyProvider, indexKey []byte) (*Encrypter, error) {
+	if provider == nil {
+		return nil, errors.New("a key provider is required")
+	}
+	if len(indexKey) < 32 {
+		return nil, errors.New("blind index key must be at least 32 bytes")
+	}
+	if revoked(indexKey) {
+		return nil, errors.Wrap(ErrRevokedKey, "blind index key")
+	}
+	return &Encrypter{
+		provider:  provider,
+		indexKey:  indexKey,
+		unwrapped: map[string][]byte{},
+	}, nil
+}
+
+// CurrentKeyID is the master key id values are currently encrypted under
+func (e *Encrypter) CurrentKeyID() string {
+	return e.provider.CurrentKeyID()
+}
+
+// Encrypt seals plaintext in an envelope. Empty strings are returned unchanged so
+// that absent values stay distinguishable from encrypted ones.
+func (e *Encrypter) Encrypt(ctx context.Context, plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+
+	dk, err := e.dataKey(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	gcm, err := newGCM(dk.plaintext)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	sealed := gcm.Seal(nonce, nonce, []byte(plaintext), nil)
+
+	return strings.Join([]string{
+		version,
+		b64.EncodeToString([]byte(dk.keyID)),
+		b64.EncodeToString(dk.wrapped),
+		b64.EncodeToString(sealed),
+	}, "."), nil
+}
+
+// Decrypt opens an envelope produced by Encrypt. Empty strings are returned unchanged.
+func (e *Encrypter) Decrypt(ctx context.Context, envelope string) (string, error) {
+	if envelope == "" {
+		return "", nil
+	}
+
+	keyID, wrapped, sealed, err := parse(envelope)
+	if err != nil {
+		return "", err
+	}
+
+	dek, err := e.unwrap(ctx, keyID, wrapped)
+	if err != nil {
+		return "", err
+	}
+
+	gcm, err := newGCM(dek)
+	if err != nil {
+		return "", err
+	}
+
+	if len(sealed) < gcm.NonceSize() {
+		return "", errors.WithStack(ErrMalformed)
+	}
+
+	plaintext, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", errors.Wrap(ErrMalformed, err.Error())
+	}
+
+	return string(plaintext), nil
+}
+
+// BlindIndex returns a deterministic keyed hash of value that can be stored and
+// queried in place of the plaintext. Empty strings have no index.
+func (e *Encrypter) BlindIndex(value string) string {
+	if value == "" {
+		return ""
+	}
+	mac := hmac.New(sha256.New, e.indexKey)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// dataKey returns the data key for new envelopes, generating one when the current key
+// has expired or the provider's master key has changed
+func (e *Encrypter) dataKey(ctx context.Context) (*dataKey, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.current != nil && time.Now().Before(e.current.expires) && e.current.keyID == e.provider.CurrentKeyID() {
+		return e.current, nil
+	}
+
+	plaintext, wrapped, keyID, err := e.provider.GenerateDataKey(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error generating data key")
+	}
+
+	e.current = &dataKey{
+		plaintext: plaintext,
+		wrapped:   wrapped,
+		keyID:     keyID,
+		expires:   time.Now().Add(dekTTL),
+	}
+	e.cache(wrapped, plaintext)
+
+	return e.current, nil
+}
+
+// unwrap returns the plaintext of a wrapped data key, asking the provider only on a cache miss
+func (e *Encrypter) unwrap(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	e.mu.Lock()
+	dek, ok := e.unwrapped[string(wrapped)]
+	e.mu.Unlock()
+	if ok {
+		return dek, nil
+	}
+
+	dek, err := e.provider.DecryptDataKey(ctx, keyID, wrapped)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error decrypting data key - "+keyID)
+	}
+
+	e.mu.Lock()
+	e.cache(wrapped, dek)
+	e.mu.Unlock()
+
+	return dek, nil
+}
+
+// cache stores an unwrapped data key, must be called with mu held
+func (e *Encrypter) cache(wrapped, plaintext []byte) {
+	if len(e.unwrapped) >= maxCachedDEKs {
+		e.unwrapped = map[string][]byte{}
+	}
+	e.unwrapped[string(wrapped)] = plaintext
+}
+
+// KeyID returns the master key id an envelope was sealed under, or an empty string
+// if the value is empty or not an envelope
+func KeyID(envelope string) string {
+	keyID, _, _, err := parse(envelope)
+	if err != nil {
+		return ""
+	}
+	return keyID
+}
+
+// parse splits an envelope into its parts
+func parse(envelope string) (keyID string, wrapped, sealed []byte, err error) {
+	parts := strings.Split(envelope, ".")
+	if len(parts) != 4 || parts[0] != version {
+		return "", nil, nil, errors.WithStack(ErrMalformed)
+	}
+
+	id, err := b64.DecodeString(parts[1])
+	if err != nil {
+		return "", nil, nil, errors.Wrap(ErrMalformed, err.Error())
+	}
+	if wrapped, err = b64.DecodeString(parts[2]); err != nil {
+		return "", nil, nil, errors.Wrap(ErrMalformed, err.Error())
+	}
+	if sealed, err = b64.DecodeString(parts[3]); err != nil {
+		return "", nil, nil, errors.Wrap(ErrMalformed, err.Error())
+	}
+
+	return string(id), wrapped, sealed, nil
+}
+
+// newGCM creates an AES-GCM AEAD for a 256 bit key
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return gcm, nil
+}
@@ -0,0 +1,109 @@
+package fieldcrypt
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/caring/go-packages/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestEncrypter(t *testing.T, current string) (*Encrypter, *Keyring) {
+	keys := map[string][]byte{
+		"k1": bytes.Repeat([]byte{1}, 32),
+		"k2": bytes.Repeat([]byte{2}, 32),
+	}
+	ring, err := NewKeyring(current, keys)
+	if ok := assert.NoError(t, err, "Expected no error"); !ok {
+		assert.FailNow(t, "keyring setup failed")
+	}
+	enc, err := NewEncrypter(ring, bytes.Repeat([]byte{9}, 32))
+	if ok := assert.NoError(t, err, "Expected no error"); !ok {
+		assert.FailNow(t, "encrypter setup failed")
+	}
+	return enc, ring
+}
+
+func TestEncrypter_roundTrip(t *testing.T) {
+	ctx := context.Background()
+	enc, _ := newTestEncrypter(t, "k1")
+
+	sealed, err := enc.Encrypt(ctx, "+15125551234")
+	assert.NoError(t, err, "Expected no error")
+	assert.NotContains(t, sealed, "5125551234", "Expected plaintext not to leak")
+	assert.Equal(t, "k1", KeyID(sealed), "Expected the current key id")
+
+	opened, err := enc.Decrypt(ctx, sealed)
+	assert.NoError(t, err, "Expected no error")
+	assert.Equal(t, "+15125551234", opened, "Expected plaintext to match")
+
+	empty, err := enc.Encrypt(ctx, "")
+	assert.NoError(t, err, "Expected no error")
+	assert.Equal(t, "", empty, "Expected empty values to stay empty")
+}
+
+func TestEncrypter_rotation(t *testing.T) {
+	ctx := context.Background()
+	old, ring := newTestEncrypter(t, "k1")
+
+	sealed, err := old.Encrypt(ctx, "+15125551234")
+	assert.NoError(t, err, "Expected no error")
+
+	// a process started after rotation can still read values sealed under the old key
+	ring.current = "k2"
+	rotated, err := NewEncrypter(ring, bytes.Repeat([]byte{9}, 32))
+	assert.NoError(t, err, "Expected no error")
+
+	opened, err := rotated.Decrypt(ctx, sealed)
+	assert.NoError(t, err, "Expected no error")
+	assert.Equal(t, "+15125551234", opened, "Expected plaintext to match")
+
+	resealed, err := rotated.Encrypt(ctx, opened)
+	assert.NoError(t, err, "Expected no error")
+	assert.Equal(t, "k2", KeyID(resealed), "Expected the rotated key id")
+
+	// once the old key is removed, old values can no longer be read
+	delete(ring.keys, "k1")
+	fresh, err := NewEncrypter(ring, bytes.Repeat([]byte{9}, 32))
+	assert.NoError(t, err, "Expected no error")
+	_, err = fresh.Decrypt(ctx, sealed)
+	assert.True(t, errors.Is(err, ErrUnknownKey), "Expected an unknown key error")
+}
+
+func TestEncrypter_BlindIndex(t *testing.T) {
+	enc, _ := newTestEncrypter(t, "k1")
+
+	assert.Equal(t, enc.BlindIndex("+15125551234"), enc.BlindIndex("+15125551234"), "Expected a deterministic index")
+	assert.NotEqual(t, enc.BlindIndex("+15125551234"), enc.BlindIndex("+15125551235"), "Expected distinct indexes")
+	assert.Len(t, enc.BlindIndex("+15125551234"), 64, "Expected a hex encoded sha256")
+	assert.Equal(t, "", enc.BlindIndex(""), "Expected no index for empty values")
+}
+
+func TestDecrypt_malformed(t *testing.T) {
+	enc, _ := newTestEncrypter(t, "k1")
+
+	_, err := enc.Decrypt(context.Background(), "5125551234")
+	assert.True(t, errors.Is(err, ErrMalformed), "Expected a malformed value error")
+}
+
+func TestRevokedKeys(t *testing.T) {
+	leaked := bytes.Repeat([]byte{3}, 32)
+	sum := sha256.Sum256(leaked)
+	revokedKeys[hex.EncodeToString(sum[:])] = true
+	defer delete(revokedKeys, hex.EncodeToString(sum[:]))
+
+	// ensures that a revoked master key cannot seal new values but can still open old ones
+	_, err := NewKeyring("leaked", map[string][]byte{"leaked": leaked})
+	assert.True(t, errors.Is(err, ErrRevokedKey), "Expected a revoked key error")
+	_, err = NewKeyring("k1", map[string][]byte{"k1": bytes.Repeat([]byte{1}, 32), "leaked": leaked})
+	assert.NoError(t, err, "Expected a revoked key to be kept for re-encryption")
+
+	// ensures that a revoked blind index key is refused
+	ring, err := NewKeyring("k1", map[string][]byte{"k1": bytes.Repeat([]byte{1}, 32)})
+	assert.NoError(t, err, "Expected no error")
+	_, err = NewEncrypter(ring, leaked)
+	assert.True(t, errors.Is(err, ErrRevokedKey), "Expected a revoked key error")
+}
@@ -0,0 +1,116 @@
+package fieldcrypt
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+// Keyring is a KeyProvider holding master keys in memory, intended for local development
+// and tests. Production deployments should use KMSProvider.
+type Keyring struct {
+	current string
+	keys    map[string][]byte
+}
+
+// keyringFile is the on disk format read by LoadKeyring, keys are base64 encoded 32 byte values
+type keyringFile struct {
+	Current string            `json:"current"`
+	Keys    map[string]string `json:"keys"`
+}
+
+// NewKeyring creates a keyring wrapping new data keys with keys[current]
+func NewKeyring(current string, keys map[string][]byte) (*Keyring, error) {
+	if _, ok := keys[current]; !ok {
+		return nil, errors.Wrap(ErrUnknownKey, "current key not in keyring - "+current)
+	}
+	for id, k := range keys {
+		if len(k) != 32 {
+			return nil, errors.New("keyring keys must be 32 bytes - " + id)
+		}
+	}
+	if revoked(keys[current]) {
+		return nil, errors.Wrap(ErrRevokedKey, "current key - "+current)
+	}
+	return &Keyring{current: current, keys: keys}, nil
+}
+
+// LoadKeyring reads a keyring from a JSON file of the form
+//   {"current": "k2", "keys": {"k1": "<base64>", "k2": "<base64>"}}
+// Rotate by adding a key and pointing current at it; keep old keys until re-encryption completes.
+func LoadKeyring(path string) (*Keyring, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	f := keyringFile{}
+	if err = json.Unmarshal(raw, &f); err != nil {
+		return nil, errors.Wrap(err, "Error parsing keyring - "+path)
+	}
+
+	keys := map[string][]byte{}
+	for id, encoded := range f.Keys {
+		k, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, errors.Wrap(err, "Error decoding keyring key - "+id)
+		}
+		keys[id] = k
+	}
+
+	return NewKeyring(f.Current, keys)
+}
+
+// CurrentKeyID implements KeyProvider
+func (k *Keyring) CurrentKeyID() string {
+	return k.current
+}
+
+// GenerateDataKey implements KeyProvider
+func (k *Keyring) GenerateDataKey(ctx context.Context) ([]byte, []byte, string, error) {
+	dek := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, dek); err != nil {
+		return nil, nil, "", errors.WithStack(err)
+	}
+
+	gcm, err := newGCM(k.keys[k.current])
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, nil, "", errors.WithStack(err)
+	}
+
+	return dek, gcm.Seal(nonce, nonce, dek, nil), k.current, nil
+}
+
+// DecryptDataKey implements KeyProvider
+func (k *Keyring) DecryptDataKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	master, ok := k.keys[keyID]
+	if !ok {
+		return nil, errors.Wrap(ErrUnknownKey, keyID)
+	}
+
+	gcm, err := newGCM(master)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(wrapped) < gcm.NonceSize() {
+		return nil, errors.WithStack(ErrMalformed)
+	}
+
+	dek, err := gcm.Open(nil, wrapped[:gcm.NonceSize()], wrapped[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, errors.Wrap(ErrMalformed, err.Error())
+	}
+
+	return dek, nil
+}
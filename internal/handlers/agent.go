@@ -0,0 +1,288 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/pb"
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+// agent states
+const (
+	AgentOffline   = "offline"
+	AgentAvailable = "available"
+	AgentAway      = "away"
+	AgentRinging   = "ringing"
+	AgentOnCall    = "on_call"
+	AgentWrapUp    = "wrap_up"
+)
+
+// presences are the states agents set themselves, every other state follows from call events
+var presences = map[string]bool{
+	AgentAvailable: true,
+	AgentAway:      true,
+	AgentOffline:   true,
+}
+
+var agentStates = map[string]bool{
+	AgentOffline:   true,
+	AgentAvailable: true,
+	AgentAway:      true,
+	AgentRinging:   true,
+	AgentOnCall:    true,
+	AgentWrapUp:    true,
+}
+
+type agentStateMethods interface {
+	Get(context.Context, int64) (*db.AgentState, error)
+	ListByCall(context.Context, int64) ([]*db.AgentState, error)
+	Transition(context.Context, *db.AgentState) (bool, error)
+}
+
+type agentMethods interface {
+	agentStateMethods
+	List(context.Context, string) ([]*db.AgentState, error)
+	History(context.Context, int64, int64, int64) ([]*db.AgentStatePeriod, error)
+}
+
+// AttachAgentStates makes the call events in registry maintain the state of the agents
+// they concern:
+//   - ringed moves the rung agent to ringing
+//   - connected and joined move the agent to on_call, and other agents ringing for the
+//     call back to available
+//   - ringed, connected and joined only move an agent who is available or already on the
+//     call, agents never seen are offline
+//   - exited moves the agent from on_call to wrap_up
+//   - disconnected moves agents on the call to wrap_up and agents ringing for it to available
+//   - dispositioned ends the wrap_up of the agent, or of every agent when none is named
+func AttachAgentStates(registry *EventRegistry, agents agentStateMethods) error {
+	for name, effect := range map[string]EventEffect{
+		RING:       movesAgent(agents, AgentRinging, nil),
+		CONNECT:    movesAgent(agents, AgentOnCall, map[string]string{AgentRinging: AgentAvailable}),
+		JOIN:       movesAgent(agents, AgentOnCall, map[string]string{AgentRinging: AgentAvailable}),
+		EXIT:       movesAgentsOnCall(agents, map[string]string{AgentOnCall: AgentWrapUp}),
+		DISCONNECT: movesAgentsOnCall(agents, map[string]string{AgentOnCall: AgentWrapUp, AgentRinging: AgentAvailable}),
+		DISPO:      movesAgentsOnCall(agents, map[string]string{AgentWrapUp: AgentAvailable}),
+	} {
+		if err := registry.Attach(name, effect); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// movesAgent is an effect that moves the event's agent into state on the event's call,
+// then moves the other agents on the call as others maps their current state. The event's
+// agent is only moved when available or already on the call, so a late or stray event does
+// not pull an agent off another call or out of a presence they chose.
+func movesAgent(agents agentStateMethods, state string, others map[string]string) EventEffect {
+	return func(ctx context.Context, e *db.Event) error {
+		if e.IdentityID == 0 {
+			return nil
+		}
+
+		current, err := agents.Get(ctx, e.IdentityID)
+		if err != nil && !errors.Is(err, db.ErrNotFound) {
+			return err
+		}
+		if current != nil && (current.State == AgentAvailable || current.CallID == e.CallID) {
+			_, err = agents.Transition(ctx, &db.AgentState{IdentityID: e.IdentityID, State: state, CallID: e.CallID, Since: e.Timestamp})
+			if err != nil {
+				return err
+			}
+		}
+		if others == nil {
+			return nil
+		}
+
+		states, err := agents.ListByCall(ctx, e.CallID)
+		if err != nil {
+			return err
+		}
+		for _, a := range states {
+			if a.IdentityID == e.IdentityID {
+				continue
+			}
+			if err = moveOnCall(ctx, agents, a, others, e.Timestamp); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// movesAgentsOnCall is an effect that moves the agents whose state concerns the event's
+// call as moves maps their current state. Only the event's agent is moved when it names one.
+func movesAgentsOnCall(agents agentStateMethods, moves map[string]string) EventEffect {
+	return func(ctx context.Context, e *db.Event) error {
+		states, err := agents.ListByCall(ctx, e.CallID)
+		if err != nil {
+			return err
+		}
+		for _, a := range states {
+			if e.IdentityID != 0 && a.IdentityID != e.IdentityID {
+				continue
+			}
+			if err = moveOnCall(ctx, agents, a, moves, e.Timestamp); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// moveOnCall moves an agent into the state moves maps their current state to, if any.
+// States outside of a call are not tied to the call they left.
+func moveOnCall(ctx context.Context, agents agentStateMethods, a *db.AgentState, moves map[string]string, ts int64) error {
+	next, ok := moves[a.State]
+	if !ok {
+		return nil
+	}
+
+	callID := a.CallID
+	if next == AgentAvailable {
+		callID = 0
+	}
+	_, err := agents.Transition(ctx, &db.AgentState{IdentityID: a.IdentityID, State: next, CallID: callID, Since: ts})
+	return err
+}
+
+// agentStateProto casts an agent's state into a proto response object as of now
+func agentStateProto(a *db.AgentState, now int64) *pb.AgentState {
+	resp := &pb.AgentState{IdentityId: a.IdentityID, State: a.State, CallId: a.CallID, Since: a.Since}
+	if a.Since > 0 {
+		resp.Seconds = span(a.Since, now)
+	}
+	return resp
+}
+
+// GetAgentState returns the current state of an agent. Agents never seen are offline.
+func GetAgentState(ctx context.Context, in *pb.AgentStateRequest, store agentMethods) (*pb.AgentState, error) {
+	a, err := store.Get(ctx, in.GetIdentityId())
+	if errors.Is(err, db.ErrNotFound) {
+		return &pb.AgentState{IdentityId: in.GetIdentityId(), State: AgentOffline}, nil
+	}
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	return agentStateProto(a, time.Now().Unix()), nil
+}
+
+func ListAgentStates(ctx context.Context, in *pb.ListAgentStatesRequest, store agentMethods) (*pb.AgentStatesResponse, error) {
+	if in.GetState() != "" && !agentStates[in.GetState()] {
+		return nil, errors.WithGrpcStatus(errors.New("unknown agent state - "+in.GetState()), codes.InvalidArgument)
+	}
+
+	states, err := store.List(ctx, in.GetState())
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	now := time.Now().Unix()
+	resp := &pb.AgentStatesResponse{Agents: make([]*pb.AgentState, 0, len(states))}
+	for _, a := range states {
+		resp.Agents = append(resp.Agents, agentStateProto(a, now))
+	}
+	return resp, nil
+}
+
+// SetAgentPresence moves an agent into a state they chose, leaving any call state they were in
+func SetAgentPresence(ctx context.Context, in *pb.AgentPresenceRequest, store agentMethods) (*pb.AgentState, error) {
+	if in.GetIdentityId() == 0 {
+		return nil, errors.WithGrpcStatus(errors.New("identity_id is required"), codes.InvalidArgument)
+	}
+	if !presences[in.GetPresence()] {
+		return nil, errors.WithGrpcStatus(errors.New("presence must be available, away or offline"), codes.InvalidArgument)
+	}
+
+	ts := in.GetTimestamp()
+	if ts <= 0 {
+		ts = time.Now().Unix()
+	}
+
+	next := &db.AgentState{IdentityID: in.GetIdentityId(), State: in.GetPresence(), Since: ts}
+	changed, err := store.Transition(ctx, next)
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+	if !changed {
+		return GetAgentState(ctx, &pb.AgentStateRequest{IdentityId: in.GetIdentityId()}, store)
+	}
+
+	return agentStateProto(next, time.Now().Unix()), nil
+}
+
+// GetAgentStateHistory lists the periods an agent spent in each state within a time range,
+// including the state they are in now
+func GetAgentStateHistory(ctx context.Context, in *pb.AgentStateHistoryRequest, store agentMethods) (*pb.AgentStateHistory, error) {
+	if in.GetFrom() <= 0 || in.GetTo() <= in.GetFrom() {
+		return nil, errors.WithGrpcStatus(errors.New("a time range with from before to is required"), codes.InvalidArgument)
+	}
+
+	periods, secondsByState, err := stateHistory(ctx, store, in.GetIdentityId(), in.GetFrom(), in.GetTo())
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	return &pb.AgentStateHistory{
+		IdentityId:     in.GetIdentityId(),
+		Periods:        periods,
+		SecondsByState: secondsByState,
+	}, nil
+}
+
+// stateHistory lists the periods an agent spent in each state clipped to [from, to),
+// including the state they are in now, and totals the time in each state
+func stateHistory(ctx context.Context, store agentMethods, identityID, from, to int64) ([]*pb.AgentStatePeriod, map[string]int64, error) {
+	periods, err := store.History(ctx, identityID, from, to)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	current, err := store.Get(ctx, identityID)
+	if err != nil && !errors.Is(err, db.ErrNotFound) {
+		return nil, nil, err
+	}
+	if current != nil {
+		periods = append(periods, &db.AgentStatePeriod{
+			IdentityID: current.IdentityID,
+			State:      current.State,
+			CallID:     current.CallID,
+			StartedAt:  current.Since,
+			EndedAt:    time.Now().Unix(),
+		})
+	}
+
+	var (
+		clipped        = []*pb.AgentStatePeriod{}
+		secondsByState = map[string]int64{}
+	)
+	for _, p := range periods {
+		start, end := p.StartedAt, p.EndedAt
+		if start < from {
+			start = from
+		}
+		if end > to {
+			end = to
+		}
+		if end <= start {
+			continue
+		}
+
+		clipped = append(clipped, &pb.AgentStatePeriod{
+			State:     p.State,
+			CallId:    p.CallID,
+			StartedAt: start,
+			EndedAt:   end,
+			Seconds:   end - start,
+		})
+		secondsByState[p.State] += end - start
+	}
+
+	return clipped, secondsByState, nil
+}
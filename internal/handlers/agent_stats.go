@@ -0,0 +1,238 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/pb"
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+type agentEventLister interface {
+	ListCallsWithIdentity(context.Context, int64, int64, int64) ([]*db.Event, error)
+	ListCallsWithEvent(context.Context, string, int64, int64) ([]*db.Event, error)
+}
+
+// otherDisposition keys the dispositions that are free text rather than a code, as free
+// text may hold caller details
+const otherDisposition = "other"
+
+// dispositionCodePattern matches a disposition that is a code
+var dispositionCodePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.\-]{0,63}$`)
+
+// AgentCall is the part a single agent played in a call
+type AgentCall struct {
+	CallID int64
+	// JoinedAt is when the agent was first connected to the call
+	JoinedAt int64
+	// OnCallSeconds is the time the agent was on the call, including their holds
+	OnCallSeconds int64
+	// HoldSeconds is the time the call spent on holds the agent placed
+	HoldSeconds int64
+	// WrapSeconds is the time from the agent leaving the call to dispositioning it
+	WrapSeconds int64
+	// Transferred is whether the agent completed a transfer of the call to another agent
+	Transferred bool
+	// Disposition is the meta of the agent's last disposition, or of an unattributed one,
+	// empty when the call was not dispositioned
+	Disposition string
+}
+
+// AgentCalls finds the calls an agent was connected to and the part they played in each.
+// events must be ordered by timestamp within each call, and may hold the events of several calls.
+func AgentCalls(events []*db.Event, identityID int64) []AgentCall {
+	calls := []AgentCall{}
+	for _, call := range splitCalls(events) {
+		if c, ok := agentCall(call, identityID); ok {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// agentCall follows an agent through the sorted events of a single call, reporting false if
+// they were never connected to it
+func agentCall(events []*db.Event, identityID int64) (AgentCall, bool) {
+	var (
+		c               = AgentCall{CallID: events[0].CallID}
+		on              bool
+		leftAt, dispoAt int64
+	)
+	join := func(ts int64) {
+		setFirst(&c.JoinedAt, ts)
+		on = true
+	}
+	leave := func(ts int64) {
+		if on {
+			leftAt, on = ts, false
+		}
+	}
+
+	for _, e := range events {
+		switch e.Type {
+		case CONNECT, JOIN:
+			if e.IdentityID == identityID {
+				join(e.Timestamp)
+			}
+		case EXIT:
+			if e.IdentityID == identityID {
+				leave(e.Timestamp)
+			}
+		case TRANSFER_CONSULTED, TRANSFER_COMPLETED:
+			t, err := ParseTransferMeta(e)
+			if err != nil {
+				continue
+			}
+			if t.ToIdentity == identityID {
+				join(e.Timestamp)
+			}
+			if e.Type == TRANSFER_COMPLETED && t.FromIdentity == identityID {
+				c.Transferred = true
+				leave(e.Timestamp)
+			}
+		case DISCONNECT:
+			leave(e.Timestamp)
+		case DISPO:
+			if e.IdentityID == identityID || e.IdentityID == 0 {
+				c.Disposition, dispoAt = e.Meta, e.Timestamp
+			}
+		}
+	}
+	if c.JoinedAt == 0 {
+		return c, false
+	}
+
+	talk := map[int64]int64{}
+	attributeTalk(events, talk)
+	c.OnCallSeconds = talk[identityID]
+	for _, h := range Holds(events) {
+		if h.IdentityID == identityID {
+			c.HoldSeconds += h.Seconds
+		}
+	}
+	c.WrapSeconds = span(leftAt, dispoAt)
+
+	return c, true
+}
+
+// GetAgentStats computes an agent's performance over the calls they joined within a time
+// range from the stored events, and their occupancy from their state history
+func GetAgentStats(ctx context.Context, in *pb.AgentStatsRequest, events agentEventLister, agents agentMethods) (*pb.AgentStats, error) {
+	if in.GetIdentityId() == 0 {
+		return nil, errors.WithGrpcStatus(errors.New("identity_id is required"), codes.InvalidArgument)
+	}
+	if in.GetFrom() <= 0 || in.GetTo() <= in.GetFrom() {
+		return nil, errors.WithGrpcStatus(errors.New("a time range with from before to is required"), codes.InvalidArgument)
+	}
+
+	recorded, err := agentCallEvents(ctx, events, in.GetIdentityId(), in.GetFrom(), in.GetTo())
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	stats := &pb.AgentStats{
+		IdentityId:   in.GetIdentityId(),
+		From:         in.GetFrom(),
+		To:           in.GetTo(),
+		Dispositions: map[string]int32{},
+	}
+
+	var talk, hold, wrap int64
+	for _, c := range AgentCalls(recorded, in.GetIdentityId()) {
+		// calls are counted in the range the agent joined them, so adjacent ranges do not overlap
+		if c.JoinedAt < in.GetFrom() || c.JoinedAt >= in.GetTo() {
+			continue
+		}
+
+		stats.CallsHandled++
+		if c.OnCallSeconds > c.HoldSeconds {
+			talk += c.OnCallSeconds - c.HoldSeconds
+		}
+		hold += c.HoldSeconds
+		wrap += c.WrapSeconds
+		if c.Transferred {
+			stats.Transfers++
+		}
+		if c.Disposition != "" {
+			stats.Dispositions[dispositionCode(c.Disposition)]++
+		}
+	}
+
+	if n := int64(stats.CallsHandled); n > 0 {
+		stats.AvgTalkSeconds = talk / n
+		stats.AvgHoldSeconds = hold / n
+		stats.AvgWrapSeconds = wrap / n
+		stats.AvgHandleSeconds = (talk + hold + wrap) / n
+		stats.TransferRate = float64(stats.Transfers) / float64(n)
+	}
+
+	_, secondsByState, err := stateHistory(ctx, agents, in.GetIdentityId(), in.GetFrom(), in.GetTo())
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+	stats.OccupiedSeconds = secondsByState[AgentRinging] + secondsByState[AgentOnCall] + secondsByState[AgentWrapUp]
+	stats.AvailableSeconds = secondsByState[AgentAvailable]
+	if staffed := stats.OccupiedSeconds + stats.AvailableSeconds; staffed > 0 {
+		stats.Occupancy = float64(stats.OccupiedSeconds) / float64(staffed)
+	}
+
+	return stats, nil
+}
+
+// agentCallEvents lists the events of the calls an agent may have joined within a time range:
+// those they had an event on, and those transferred within the range, as a transfer's target
+// is only named in its meta. Events are ordered by call and then timestamp.
+func agentCallEvents(ctx context.Context, events agentEventLister, identityID, from, to int64) ([]*db.Event, error) {
+	lists := make([][]*db.Event, 0, 3)
+	recorded, err := events.ListCallsWithIdentity(ctx, identityID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	lists = append(lists, recorded)
+	for _, eventType := range []string{TRANSFER_CONSULTED, TRANSFER_COMPLETED} {
+		if recorded, err = events.ListCallsWithEvent(ctx, eventType, from, to); err != nil {
+			return nil, err
+		}
+		lists = append(lists, recorded)
+	}
+
+	var (
+		calls = [][]*db.Event{}
+		seen  = map[int64]bool{}
+	)
+	for _, list := range lists {
+		for _, call := range splitCalls(list) {
+			if !seen[call[0].CallID] {
+				seen[call[0].CallID] = true
+				calls = append(calls, call)
+			}
+		}
+	}
+	sort.Slice(calls, func(i, j int) bool { return calls[i][0].CallID < calls[j][0].CallID })
+
+	merged := []*db.Event{}
+	for _, call := range calls {
+		merged = append(merged, call...)
+	}
+	return merged, nil
+}
+
+// dispositionCode is the code of a disposition's meta: its "code" key when the meta is a JSON
+// object, or the meta itself when it is a code. Anything else is otherDisposition.
+func dispositionCode(meta string) string {
+	var m struct {
+		Code string `json:"code"`
+	}
+	if err := json.Unmarshal([]byte(meta), &m); err == nil {
+		meta = m.Code
+	}
+	if dispositionCodePattern.MatchString(meta) {
+		return meta
+	}
+	return otherDisposition
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeAgentEvents struct {
+	events      []*db.Event
+	transferred []*db.Event
+}
+
+func (f *fakeAgentEvents) ListCallsWithIdentity(ctx context.Context, identityID, from, to int64) ([]*db.Event, error) {
+	return f.events, nil
+}
+
+func (f *fakeAgentEvents) ListCallsWithEvent(ctx context.Context, eventType string, from, to int64) ([]*db.Event, error) {
+	if eventType != TRANSFER_COMPLETED {
+		return []*db.Event{}, nil
+	}
+	return f.transferred, nil
+}
+
+func TestGetAgentStats(t *testing.T) {
+	events := &fakeAgentEvents{events: []*db.Event{
+		// handled with a hold and wrap up
+		{CallID: 1, Type: CONNECT, IdentityID: 7, Timestamp: 100},
+		{CallID: 1, Type: HOLD, IdentityID: 7, Timestamp: 120},
+		{CallID: 1, Type: RESUME, IdentityID: 7, Timestamp: 140},
+		{CallID: 1, Type: DISCONNECT, Timestamp: 200},
+		{CallID: 1, Type: DISPO, IdentityID: 7, Timestamp: 230, Meta: "sale"},
+		// transferred away without a disposition
+		{CallID: 2, Type: CONNECT, IdentityID: 7, Timestamp: 300},
+		{CallID: 2, Type: TRANSFER_COMPLETED, IdentityID: 7, Timestamp: 360, Meta: `{"kind": "cold", "to_identity": 8}`},
+		{CallID: 2, Type: DISCONNECT, Timestamp: 400},
+		// joined before the range
+		{CallID: 3, Type: CONNECT, IdentityID: 7, Timestamp: 50},
+		{CallID: 3, Type: DISCONNECT, Timestamp: 150},
+		// rung but never connected
+		{CallID: 4, Type: RING, IdentityID: 7, Timestamp: 500},
+		{CallID: 4, Type: DISCONNECT, Timestamp: 510},
+	}, transferred: []*db.Event{
+		// already listed for the agent
+		{CallID: 2, Type: CONNECT, IdentityID: 7, Timestamp: 300},
+		{CallID: 2, Type: TRANSFER_COMPLETED, IdentityID: 7, Timestamp: 360, Meta: `{"kind": "cold", "to_identity": 8}`},
+		{CallID: 2, Type: DISCONNECT, Timestamp: 400},
+		// transferred to the agent, who has no event of their own on it
+		{CallID: 5, Type: CONNECT, IdentityID: 8, Timestamp: 600},
+		{CallID: 5, Type: TRANSFER_COMPLETED, IdentityID: 8, Timestamp: 620, Meta: `{"kind": "cold", "to_identity": 7}`},
+		{CallID: 5, Type: DISCONNECT, Timestamp: 680},
+		{CallID: 5, Type: DISPO, IdentityID: 7, Timestamp: 700, Meta: "caller asked to be called back on 512-555-1234"},
+	}}
+	agents := &fakeAgents{
+		states: map[int64]*db.AgentState{},
+		history: []*db.AgentStatePeriod{
+			{IdentityID: 7, State: AgentAvailable, StartedAt: 60, EndedAt: 100},
+			{IdentityID: 7, State: AgentOnCall, StartedAt: 100, EndedAt: 200},
+			{IdentityID: 7, State: AgentWrapUp, StartedAt: 200, EndedAt: 230},
+			{IdentityID: 7, State: AgentAvailable, StartedAt: 230, EndedAt: 300},
+			{IdentityID: 7, State: AgentOnCall, StartedAt: 300, EndedAt: 360},
+			{IdentityID: 7, State: AgentAway, StartedAt: 360, EndedAt: 1000},
+		},
+	}
+
+	stats, err := GetAgentStats(context.Background(), &pb.AgentStatsRequest{IdentityId: 7, From: 100, To: 1000}, events, agents)
+	assert.NoError(t, err, "Expected no error")
+	assert.Equal(t, int32(3), stats.GetCallsHandled(), "Expected the calls joined within the range, including by transfer")
+	assert.Equal(t, int64(66), stats.GetAvgTalkSeconds(), "Expected the talk time without holds")
+	assert.Equal(t, int64(6), stats.GetAvgHoldSeconds(), "Expected the hold time")
+	assert.Equal(t, int64(16), stats.GetAvgWrapSeconds(), "Expected the wrap up time")
+	assert.Equal(t, int64(90), stats.GetAvgHandleSeconds(), "Expected talk, hold and wrap up together")
+	assert.InDelta(t, 1.0/3.0, stats.GetTransferRate(), 0.0001, "Expected one of three calls transferred")
+	assert.Equal(t, map[string]int32{"sale": 1, otherDisposition: 1}, stats.GetDispositions(), "Expected codes, free text as other and no undispositioned bucket")
+	assert.Equal(t, int64(190), stats.GetOccupiedSeconds(), "Expected the time on calls and in wrap up")
+	assert.Equal(t, int64(70), stats.GetAvailableSeconds(), "Expected the time available within the range")
+	assert.InDelta(t, 190.0/260.0, stats.GetOccupancy(), 0.0001, "Expected the occupancy")
+}
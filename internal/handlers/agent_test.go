@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeAgents keeps the current state of each agent and the periods they completed
+type fakeAgents struct {
+	states  map[int64]*db.AgentState
+	history []*db.AgentStatePeriod
+}
+
+func (f *fakeAgents) Get(ctx context.Context, identityID int64) (*db.AgentState, error) {
+	a, ok := f.states[identityID]
+	if !ok {
+		return nil, db.ErrNotFound
+	}
+	return a, nil
+}
+
+func (f *fakeAgents) List(ctx context.Context, state string) ([]*db.AgentState, error) {
+	states := []*db.AgentState{}
+	for _, a := range f.states {
+		if state == "" || a.State == state {
+			states = append(states, a)
+		}
+	}
+	return states, nil
+}
+
+func (f *fakeAgents) ListByCall(ctx context.Context, callID int64) ([]*db.AgentState, error) {
+	states := []*db.AgentState{}
+	for _, a := range f.states {
+		if a.CallID == callID {
+			states = append(states, a)
+		}
+	}
+	return states, nil
+}
+
+func (f *fakeAgents) Transition(ctx context.Context, next *db.AgentState) (bool, error) {
+	if prev, ok := f.states[next.IdentityID]; ok {
+		if prev.State == next.State && prev.CallID == next.CallID {
+			return false, nil
+		}
+		f.history = append(f.history, &db.AgentStatePeriod{IdentityID: prev.IdentityID, State: prev.State, CallID: prev.CallID, StartedAt: prev.Since, EndedAt: next.Since})
+	}
+	f.states[next.IdentityID] = next
+	return true, nil
+}
+
+func (f *fakeAgents) History(ctx context.Context, identityID, from, to int64) ([]*db.AgentStatePeriod, error) {
+	periods := []*db.AgentStatePeriod{}
+	for _, p := range f.history {
+		if p.IdentityID == identityID {
+			periods = append(periods, p)
+		}
+	}
+	return periods, nil
+}
+
+func TestAttachAgentStates(t *testing.T) {
+	agents := &fakeAgents{states: map[int64]*db.AgentState{
+		7:  {IdentityID: 7, State: AgentAvailable, Since: 100},
+		8:  {IdentityID: 8, State: AgentAvailable, Since: 100},
+		9:  {IdentityID: 9, State: AgentOnCall, CallID: 2000, Since: 90},
+		10: {IdentityID: 10, State: AgentAway, Since: 90},
+	}}
+	registry, err := NewEventRegistry(BuiltinEventTypes(nil, nil, nil)...)
+	if ok := assert.NoError(t, err, "Expected no error"); !ok {
+		assert.FailNow(t, "test setup failed")
+	}
+	// drop the builtin effects, which need stores of their own
+	for _, name := range registry.Names() {
+		et, _ := registry.Lookup(name)
+		et.Effects = nil
+	}
+	if ok := assert.NoError(t, AttachAgentStates(registry, agents), "Expected no error"); !ok {
+		assert.FailNow(t, "test setup failed")
+	}
+
+	record := func(eventType string, identityID, ts int64) {
+		_, err := RecordEvent(context.Background(), &pb.EventRequest{
+			Event: &pb.Event{CallId: 1000, IdentityId: identityID, Timestamp: ts, EventType: eventType, Meta: "sale"},
+		}, &fakeEventStore{}, registry)
+		assert.NoError(t, err, "Expected the event to be recorded")
+	}
+	state := func(identityID int64) string {
+		return agents.states[identityID].State
+	}
+
+	// ensures that the agent who answers is on the call and the other agent rung is released
+	record(RING, 7, 100)
+	record(RING, 8, 100)
+	record(CONNECT, 7, 110)
+	assert.Equal(t, AgentOnCall, state(7), "Expected the answering agent on the call")
+	assert.Equal(t, AgentAvailable, state(8), "Expected the other agent to be available")
+
+	// ensures that agents on another call, away or never seen are not moved onto the call
+	record(RING, 9, 120)
+	record(JOIN, 9, 120)
+	assert.Equal(t, AgentOnCall, state(9), "Expected the agent to stay on their call")
+	assert.Equal(t, int64(2000), agents.states[9].CallID, "Expected the agent to stay on their call")
+	record(CONNECT, 10, 120)
+	assert.Equal(t, AgentAway, state(10), "Expected the away agent to stay away")
+	record(RING, 11, 120)
+	assert.Nil(t, agents.states[11], "Expected the unknown agent to stay offline")
+
+	// ensures that the call ends in wrap up until it is dispositioned
+	record(DISCONNECT, 0, 200)
+	assert.Equal(t, AgentWrapUp, state(7), "Expected the agent in wrap up")
+	record(DISPO, 7, 230)
+	assert.Equal(t, AgentAvailable, state(7), "Expected the agent available again")
+
+	hist, err := GetAgentStateHistory(context.Background(), &pb.AgentStateHistoryRequest{IdentityId: 7, From: 100, To: 230}, agents)
+	assert.NoError(t, err, "Expected no error")
+	assert.Equal(t, map[string]int64{AgentRinging: 10, AgentOnCall: 90, AgentWrapUp: 30}, hist.GetSecondsByState(), "Expected time in each state")
+}
@@ -0,0 +1,297 @@
+package handlers
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/internal/phone"
+	"github.com/caring/call-handling/pb"
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+// number block lists
+const (
+	// Blocklist screens the callers of inbound calls
+	Blocklist = "blocklist"
+	// DoNotCallList screens the numbers dialed by outbound calls
+	DoNotCallList = "dnc"
+)
+
+// number block actions
+const (
+	BlockReject = "reject"
+	BlockFlag   = "flag"
+)
+
+// call directions
+const (
+	Inbound  = "inbound"
+	Outbound = "outbound"
+)
+
+// maxImportRows caps the rows of a single number block import
+const maxImportRows = 10000
+
+// prefixPattern matches an E.164 prefix, which cannot contain LIKE wildcards
+var prefixPattern = regexp.MustCompile(`^\+[0-9]{1,15}$`)
+
+type blockMatcher interface {
+	Match(context.Context, string, string) ([]*db.NumberBlock, error)
+	RecordBlockedCall(context.Context, *db.BlockedCall, string) error
+}
+
+type blockMethods interface {
+	blockMatcher
+	Create(context.Context, *db.NumberBlock) error
+	CreateBatch(context.Context, []*db.NumberBlock) error
+	Get(context.Context, int64) (*db.NumberBlock, error)
+	List(context.Context, string, int) ([]*db.NumberBlock, error)
+	Delete(context.Context, int64) error
+	ListBlockedCalls(context.Context, db.BlockedCallFilter, int) ([]*db.BlockedCall, error)
+}
+
+// screenCall finds the number block that applies to a call, screening an inbound call's
+// ANI against the blocklist and an outbound call's DNIS against the do-not-call list. A
+// rejecting entry wins over a flagging one, and an exact entry over a prefix. Numbers that
+// did not normalize cannot be screened.
+func screenCall(ctx context.Context, call *db.Call, direction string, blocks blockMatcher) (block *db.NumberBlock, number string, err error) {
+	list, number := Blocklist, call.ANIE164
+	if direction == Outbound {
+		list, number = DoNotCallList, call.DNISE164
+	}
+	if number == "" {
+		return nil, "", nil
+	}
+
+	matched, err := blocks.Match(ctx, list, number)
+	if err != nil {
+		return nil, "", errors.WithGrpcStatus(err, codes.Internal)
+	}
+	if len(matched) == 0 {
+		return nil, number, nil
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		a, b := matched[i], matched[j]
+		if (a.Action == BlockReject) != (b.Action == BlockReject) {
+			return a.Action == BlockReject
+		}
+		if (a.Prefix == "") != (b.Prefix == "") {
+			return a.Prefix == ""
+		}
+		return len(a.Prefix) > len(b.Prefix)
+	})
+
+	return matched[0], number, nil
+}
+
+// rejectCall audits a call refused by a number block and returns the error it is refused with,
+// carrying code
+func rejectCall(ctx context.Context, call *db.Call, block *db.NumberBlock, number string, blocks blockMatcher, code codes.Code) error {
+	err := blocks.RecordBlockedCall(ctx, &db.BlockedCall{CallID: call.ID, BlockID: block.ID, List: block.List, Action: block.Action}, number)
+	if err != nil {
+		return errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	if block.List == DoNotCallList {
+		return errors.WithGrpcStatus(errors.New("the number dialed is on the do-not-call list"), code)
+	}
+	return errors.WithGrpcStatus(errors.New("the caller is on the blocklist"), code)
+}
+
+// numberBlock validates a requested number block, normalizing its number in region
+func numberBlock(region, list, number, prefix, action, reason string) (*db.NumberBlock, error) {
+	if list != Blocklist && list != DoNotCallList {
+		return nil, errors.New("list must be blocklist or dnc")
+	}
+	if action == "" {
+		action = BlockReject
+	}
+	if action != BlockReject && action != BlockFlag {
+		return nil, errors.New("action must be reject or flag")
+	}
+
+	var (
+		b   = &db.NumberBlock{List: list, Action: action, Reason: reason}
+		err error
+	)
+	switch {
+	case number != "" && prefix != "":
+		return nil, errors.New("only one of number and prefix may be set")
+	case number != "":
+		if b.Number, err = phone.Normalize(number, region); err != nil {
+			return nil, err
+		}
+	case prefix != "":
+		if !prefixPattern.MatchString(prefix) {
+			return nil, errors.New("prefix must be + followed by digits")
+		}
+		b.Prefix = prefix
+	default:
+		return nil, errors.New("a number or prefix is required")
+	}
+
+	return b, nil
+}
+
+// CreateNumberBlock adds a number or prefix to a list, or updates the entry already blocking it
+func CreateNumberBlock(ctx context.Context, in *pb.NumberBlockRequest, store blockMethods) (*pb.NumberBlock, error) {
+	tenant, err := db.TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Unauthenticated)
+	}
+
+	b, err := numberBlock(tenant.Config.DefaultRegion, in.GetList(), in.GetNumber(), in.GetPrefix(), in.GetAction(), in.GetReason())
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.InvalidArgument)
+	}
+
+	if err = store.Create(ctx, b); err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	// an existing entry keeps its creation time, so read back what was stored
+	if b, err = store.Get(ctx, b.ID); err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+	return b.ToProto(), nil
+}
+
+// ImportNumberBlocks adds the rows of a CSV to a list in a single transaction. Rows that
+// do not validate are skipped and reported, the rest are stored.
+func ImportNumberBlocks(ctx context.Context, in *pb.ImportNumberBlocksRequest, store blockMethods) (*pb.ImportNumberBlocksResponse, error) {
+	tenant, err := db.TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Unauthenticated)
+	}
+	if in.GetList() != Blocklist && in.GetList() != DoNotCallList {
+		return nil, errors.WithGrpcStatus(errors.New("list must be blocklist or dnc"), codes.InvalidArgument)
+	}
+
+	r := csv.NewReader(strings.NewReader(in.GetCsv()))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, errors.WithGrpcStatus(errors.New("a CSV with a header row is required"), codes.InvalidArgument)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		switch name {
+		case "number", "prefix", "action", "reason":
+			columns[name] = i
+		default:
+			return nil, errors.WithGrpcStatus(errors.New("unknown CSV column - "+name), codes.InvalidArgument)
+		}
+	}
+	field := func(row []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(row) {
+			return strings.TrimSpace(row[i])
+		}
+		return ""
+	}
+
+	var (
+		resp   = &pb.ImportNumberBlocksResponse{}
+		blocks = []*db.NumberBlock{}
+	)
+	for line := int32(2); ; line++ {
+		row, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.WithGrpcStatus(err, codes.InvalidArgument)
+		}
+		if line > maxImportRows+1 {
+			return nil, errors.WithGrpcStatus(errors.New("a CSV may have at most 10000 rows"), codes.InvalidArgument)
+		}
+
+		b, err := numberBlock(tenant.Config.DefaultRegion, in.GetList(),
+			field(row, "number"), field(row, "prefix"), field(row, "action"), field(row, "reason"))
+		if err != nil {
+			resp.Errors = append(resp.Errors, &pb.NumberBlockImportError{Line: line, Error: err.Error()})
+			continue
+		}
+		blocks = append(blocks, b)
+	}
+
+	if len(blocks) > 0 {
+		if err = store.CreateBatch(ctx, blocks); err != nil {
+			return nil, errors.WithGrpcStatus(err, codes.Internal)
+		}
+	}
+
+	resp.Imported = int32(len(blocks))
+	return resp, nil
+}
+
+// ListNumberBlocks lists the most recent number blocks, optionally of one list
+func ListNumberBlocks(ctx context.Context, in *pb.ListNumberBlocksRequest, store blockMethods) (*pb.NumberBlocksResponse, error) {
+	if in.GetList() != "" && in.GetList() != Blocklist && in.GetList() != DoNotCallList {
+		return nil, errors.WithGrpcStatus(errors.New("list must be blocklist or dnc"), codes.InvalidArgument)
+	}
+
+	blocks, err := store.List(ctx, in.GetList(), listLimit(in.GetLimit()))
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	resp := &pb.NumberBlocksResponse{}
+	for _, b := range blocks {
+		resp.Blocks = append(resp.Blocks, b.ToProto())
+	}
+	return resp, nil
+}
+
+// DeleteNumberBlock removes a number block, responding with the entry removed
+func DeleteNumberBlock(ctx context.Context, in *pb.DeleteNumberBlockRequest, store blockMethods) (*pb.NumberBlock, error) {
+	b, err := store.Get(ctx, in.GetBlockId())
+	if err == nil {
+		err = store.Delete(ctx, in.GetBlockId())
+	}
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) || errors.Is(err, db.ErrNoRowsAffected) {
+			return nil, errors.WithGrpcStatus(err, codes.NotFound)
+		}
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	return b.ToProto(), nil
+}
+
+// ListBlockedCalls lists the most recent calls rejected or flagged by a number block
+func ListBlockedCalls(ctx context.Context, in *pb.ListBlockedCallsRequest, store blockMethods) (*pb.BlockedCallsResponse, error) {
+	if in.GetFrom() <= 0 || in.GetTo() <= in.GetFrom() {
+		return nil, errors.WithGrpcStatus(errors.New("a time range with from before to is required"), codes.InvalidArgument)
+	}
+	if in.GetList() != "" && in.GetList() != Blocklist && in.GetList() != DoNotCallList {
+		return nil, errors.WithGrpcStatus(errors.New("list must be blocklist or dnc"), codes.InvalidArgument)
+	}
+
+	filter := db.BlockedCallFilter{
+		From: time.Unix(in.GetFrom(), 0),
+		To:   time.Unix(in.GetTo(), 0),
+		List: in.GetList(),
+	}
+	blocked, err := store.ListBlockedCalls(ctx, filter, listLimit(in.GetLimit()))
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	resp := &pb.BlockedCallsResponse{}
+	for _, b := range blocked {
+		resp.BlockedCalls = append(resp.BlockedCalls, b.ToProto())
+	}
+	return resp, nil
+}
@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeBlocks matches numbers against its entries and records the calls they block
+type fakeBlocks struct {
+	blocks  []*db.NumberBlock
+	blocked []*db.BlockedCall
+}
+
+func (f *fakeBlocks) Match(ctx context.Context, list, e164 string) ([]*db.NumberBlock, error) {
+	matched := []*db.NumberBlock{}
+	for _, b := range f.blocks {
+		if b.List == list && (b.Number == e164 || b.Prefix != "" && strings.HasPrefix(e164, b.Prefix)) {
+			matched = append(matched, b)
+		}
+	}
+	return matched, nil
+}
+
+func (f *fakeBlocks) RecordBlockedCall(ctx context.Context, b *db.BlockedCall, e164 string) error {
+	f.blocked = append(f.blocked, b)
+	return nil
+}
+
+func (f *fakeBlocks) Create(ctx context.Context, b *db.NumberBlock) error {
+	b.ID = int64(len(f.blocks) + 1)
+	f.blocks = append(f.blocks, b)
+	return nil
+}
+
+func (f *fakeBlocks) CreateBatch(ctx context.Context, blocks []*db.NumberBlock) error {
+	for _, b := range blocks {
+		f.Create(ctx, b)
+	}
+	return nil
+}
+
+func (f *fakeBlocks) Get(ctx context.Context, ID int64) (*db.NumberBlock, error) {
+	for _, b := range f.blocks {
+		if b.ID == ID {
+			return b, nil
+		}
+	}
+	return nil, db.ErrNotFound
+}
+
+func (f *fakeBlocks) List(ctx context.Context, list string, limit int) ([]*db.NumberBlock, error) {
+	return f.blocks, nil
+}
+
+func (f *fakeBlocks) Delete(ctx context.Context, ID int64) error {
+	return nil
+}
+
+func (f *fakeBlocks) ListBlockedCalls(ctx context.Context, filter db.BlockedCallFilter, limit int) ([]*db.BlockedCall, error) {
+	return f.blocked, nil
+}
+
+func TestCreateCall_screening(t *testing.T) {
+	ctx := db.TenantToCtx(context.Background(), &db.Tenant{ID: "a", Config: db.TenantConfig{DefaultRegion: "US"}})
+	blocks := &fakeBlocks{blocks: []*db.NumberBlock{
+		{ID: 1, List: Blocklist, Prefix: "+1900", Action: BlockReject},
+		{ID: 2, List: Blocklist, Prefix: "+1512", Action: BlockFlag},
+		{ID: 3, List: Blocklist, Number: "+15125551234", Action: BlockFlag},
+		{ID: 4, List: DoNotCallList, Number: "+15125550000", Action: BlockReject},
+	}}
+	store := &fakeCalls{}
+
+	// ensures that a rejected caller is refused and audited without storing the call
+	_, err := CreateCall(ctx, &pb.CallRequest{Call: &pb.Call{CallId: 1, ANI: "900-555-0100"}}, store, &fakeHoldEvents{}, blocks)
+	s, _ := status.FromError(err)
+	assert.Equal(t, codes.PermissionDenied, s.Code(), "Expected the call to be refused")
+	assert.Empty(t, store.calls, "Expected the call not to be stored")
+	assert.Equal(t, []*db.BlockedCall{{CallID: 1, BlockID: 1, List: Blocklist, Action: BlockReject}}, blocks.blocked, "Expected the refusal to be audited")
+
+	// ensures that a flagged caller is stored and marked with the exact entry over the prefix
+	resp, err := CreateCall(ctx, &pb.CallRequest{Call: &pb.Call{CallId: 2, ANI: "512-555-1234"}}, store, &fakeHoldEvents{}, blocks)
+	assert.NoError(t, err, "Expected no error")
+	assert.Equal(t, int64(3), resp.GetFlaggedBy().GetBlockId(), "Expected the exact entry to flag the call")
+	assert.Len(t, store.calls, 1, "Expected the call to be stored")
+	assert.Len(t, blocks.blocked, 2, "Expected the flag to be audited")
+	assert.Equal(t, BlockFlag, resp.GetBlockAction(), "Expected the flag to be stored with the call")
+	assert.Equal(t, Inbound, resp.GetDirection(), "Expected the call to default to inbound")
+
+	// ensures that outbound calls are screened by DNIS against the do-not-call list only
+	_, err = CreateCall(ctx, &pb.CallRequest{Call: &pb.Call{CallId: 3, ANI: "900-555-0100", DNIS: "512-555-0000", Direction: Outbound}},
+		store, &fakeHoldEvents{}, blocks)
+	s, _ = status.FromError(err)
+	assert.Equal(t, codes.PermissionDenied, s.Code(), "Expected the outbound call to be refused")
+	assert.Equal(t, int64(4), blocks.blocked[2].BlockID, "Expected the do-not-call entry to be audited")
+
+	resp, err = CreateCall(ctx, &pb.CallRequest{Call: &pb.Call{CallId: 4, ANI: "900-555-0100", DNIS: "512-555-0001", Direction: Outbound}},
+		store, &fakeHoldEvents{}, blocks)
+	assert.NoError(t, err, "Expected the blocklisted ANI not to affect an outbound call")
+	assert.Nil(t, resp.GetFlaggedBy(), "Expected the call not to be flagged")
+	assert.Equal(t, Outbound, resp.GetDirection(), "Expected the call to be stored as outbound")
+}
+
+func TestImportNumberBlocks(t *testing.T) {
+	ctx := db.TenantToCtx(context.Background(), &db.Tenant{ID: "a", Config: db.TenantConfig{DefaultRegion: "US"}})
+	blocks := &fakeBlocks{}
+
+	resp, err := ImportNumberBlocks(ctx, &pb.ImportNumberBlocksRequest{
+		List: DoNotCallList,
+		Csv: "number,prefix,action,reason\n" +
+			"(512) 555-1234,,,asked not to be called\n" +
+			",+1900,flag,premium rate\n" +
+			"512-555-1234,+1512,,\n" +
+			"not a number,,,\n" +
+			",1900,,\n" +
+			"512-555-0000,,block,\n",
+	}, blocks)
+	assert.NoError(t, err, "Expected no error")
+	assert.Equal(t, int32(2), resp.GetImported(), "Expected the valid rows to be imported")
+	assert.Equal(t, []int32{4, 5, 6, 7}, func() []int32 {
+		lines := []int32{}
+		for _, e := range resp.GetErrors() {
+			lines = append(lines, e.GetLine())
+		}
+		return lines
+	}(), "Expected the invalid rows to be reported by line")
+	assert.Equal(t, []*db.NumberBlock{
+		{ID: 1, List: DoNotCallList, Number: "+15125551234", Action: BlockReject, Reason: "asked not to be called"},
+		{ID: 2, List: DoNotCallList, Prefix: "+1900", Action: BlockFlag, Reason: "premium rate"},
+	}, blocks.blocks, "Expected the rows to be normalized")
+
+	// ensures that an unknown column fails the whole import
+	_, err = ImportNumberBlocks(ctx, &pb.ImportNumberBlocksRequest{List: DoNotCallList, Csv: "phone\n5125551234\n"}, blocks)
+	s, _ := status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, s.Code(), "Expected an invalid argument error")
+}
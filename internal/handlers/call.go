@@ -2,26 +2,246 @@ package handlers
 
 import (
 	"context"
+	"time"
 
 	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/internal/phone"
 	"github.com/caring/call-handling/pb"
 	"github.com/caring/go-packages/pkg/errors"
 	"google.golang.org/grpc/codes"
 )
 
+const (
+	// defaultCallsLimit caps list responses when the caller gives no limit
+	defaultCallsLimit = 50
+	// maxCallsLimit caps list responses regardless of the requested limit
+	maxCallsLimit = 500
+)
+
 type callMethods interface {
+	callerHistorian
 	Create(context.Context, *db.Call) error
+	Get(context.Context, int64) (*db.Call, error)
+	ListByANI(context.Context, string, int) ([]*db.Call, error)
+	List(context.Context, db.CallFilter, int) ([]*db.Call, error)
+	SetLegalHold(context.Context, int64, bool, string) error
 }
 
-func CreateCall(ctx context.Context, in *pb.CallRequest, store callMethods) (resp *pb.CallResponse, err error) {
+type callerHistorian interface {
+	CallerHistory(context.Context, string, int64) (*db.CallerHistory, error)
+}
+
+// CreateCall stores a new call and responds with it and the caller's earlier calls. Calls
+// matching a rejecting number block are refused and audited instead of stored, and calls
+// matching a flagging one are stored, audited and marked with the block.
+func CreateCall(ctx context.Context, in *pb.CallRequest, store callMethods, events eventLister, blocks blockMatcher) (resp *pb.CallResponse, err error) {
 	var (
-		call *db.Call
+		call    *db.Call
+		history *pb.CallerHistory
 	)
+	direction := in.GetCall().GetDirection()
+	if direction == "" {
+		direction = Inbound
+	}
+	if direction != Inbound && direction != Outbound {
+		return nil, errors.WithGrpcStatus(errors.New("direction must be inbound or outbound"), codes.InvalidArgument)
+	}
 	call = db.NewCall(in)
+	if err = normalizeNumbers(ctx, call); err != nil {
+		return nil, err
+	}
+	block, number, err := screenCall(ctx, call, direction, blocks)
+	if err != nil {
+		return nil, err
+	}
+	if block != nil && block.Action == BlockReject {
+		return nil, rejectCall(ctx, call, block, number, blocks, codes.PermissionDenied)
+	}
+	call.Direction = direction
+	if block != nil {
+		call.BlockID, call.BlockAction = block.ID, block.Action
+	}
+	// the history is read before the call is stored so that it only covers earlier calls. It
+	// only informs the agent, so a call is stored without it when it cannot be read. The
+	// history of an outbound call is that of the party called.
+	party := call.ANIE164
+	if direction == Outbound {
+		party = call.DNISE164
+	}
+	if party != "" {
+		history, _ = callerHistory(ctx, party, call.ID, store, events)
+	}
 	err = store.Create(ctx, call)
+	if err == nil && block != nil {
+		err = blocks.RecordBlockedCall(ctx, &db.BlockedCall{CallID: call.ID, BlockID: block.ID, List: block.List, Action: block.Action}, number)
+	}
 	if err != nil {
 		err = errors.WithGrpcStatus(err, codes.Internal)
 	}
 	resp = call.ToProto()
+	resp.CallerHistory = history
+	if block != nil {
+		resp.FlaggedBy = block.ToProto()
+	}
 	return
 }
+
+// GetCallerHistory summarizes the calls from a number and the outbound calls placed to it
+func GetCallerHistory(ctx context.Context, in *pb.CallerHistoryRequest, store callMethods, events eventLister) (*pb.CallerHistory, error) {
+	tenant, err := db.TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Unauthenticated)
+	}
+
+	number, err := phone.Normalize(in.GetNumber(), tenant.Config.DefaultRegion)
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.InvalidArgument)
+	}
+
+	return callerHistory(ctx, number, 0, store, events)
+}
+
+// callerHistory summarizes the calls from a normalized number other than the call exclude,
+// taking the last disposition and agent from the events of the most recent call. The last
+// agent is the last one connected to the call, whether they answered it, joined it or had it
+// transferred to them.
+func callerHistory(ctx context.Context, aniE164 string, exclude int64, store callerHistorian, events eventLister) (*pb.CallerHistory, error) {
+	h, err := store.CallerHistory(ctx, aniE164, exclude)
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	resp := &pb.CallerHistory{Calls: h.Calls, LastCallId: h.LastCallID}
+	if h.LastCallID == 0 {
+		return resp, nil
+	}
+	resp.LastCallAt = h.LastCallAt.Unix()
+
+	recorded, err := events.ListByCall(ctx, h.LastCallID)
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+	for _, e := range recorded {
+		switch e.Type {
+		case CONNECT, JOIN:
+			if e.IdentityID != 0 {
+				resp.LastIdentityId = e.IdentityID
+			}
+		case TRANSFER_COMPLETED:
+			if t, err := ParseTransferMeta(e); err == nil && t.ToIdentity != 0 {
+				resp.LastIdentityId = t.ToIdentity
+			}
+		case DISPO:
+			resp.LastDisposition = e.Meta
+		}
+	}
+
+	return resp, nil
+}
+
+func ListCallsByNumber(ctx context.Context, in *pb.CallsByNumberRequest, store callMethods) (*pb.CallsResponse, error) {
+	tenant, err := db.TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Unauthenticated)
+	}
+
+	number, err := phone.Normalize(in.GetNumber(), tenant.Config.DefaultRegion)
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.InvalidArgument)
+	}
+
+	calls, err := store.ListByANI(ctx, number, listLimit(in.GetLimit()))
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	resp := &pb.CallsResponse{}
+	for _, c := range calls {
+		resp.Calls = append(resp.Calls, c.ToProto())
+	}
+	return resp, nil
+}
+
+// ListCalls lists the most recent calls created in a time range, optionally by outcome
+func ListCalls(ctx context.Context, in *pb.ListCallsRequest, store callMethods) (*pb.CallsResponse, error) {
+	if in.GetFrom() <= 0 || in.GetTo() <= in.GetFrom() {
+		return nil, errors.WithGrpcStatus(errors.New("a time range with from before to is required"), codes.InvalidArgument)
+	}
+	if in.GetOutcome() != "" && !IsOutcome(in.GetOutcome()) {
+		return nil, errors.WithGrpcStatus(errors.New("unknown outcome - "+in.GetOutcome()), codes.InvalidArgument)
+	}
+
+	filter := db.CallFilter{
+		From:    time.Unix(in.GetFrom(), 0),
+		To:      time.Unix(in.GetTo(), 0),
+		Outcome: in.GetOutcome(),
+	}
+	calls, err := store.List(ctx, filter, listLimit(in.GetLimit()))
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	resp := &pb.CallsResponse{}
+	for _, c := range calls {
+		resp.Calls = append(resp.Calls, c.ToProto())
+	}
+	return resp, nil
+}
+
+func SetLegalHold(ctx context.Context, in *pb.LegalHoldRequest, store callMethods) (*pb.LegalHoldResponse, error) {
+	err := store.SetLegalHold(ctx, in.GetCallId(), in.GetHold(), in.GetReason())
+	if errors.Is(err, db.ErrNoRowsAffected) {
+		// an unchanged row is not affected, so tell a repeated request apart from a missing call
+		_, err = store.Get(ctx, in.GetCallId())
+	}
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, errors.WithGrpcStatus(err, codes.NotFound)
+		}
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	return &pb.LegalHoldResponse{CallId: in.GetCallId(), Hold: in.GetHold()}, nil
+}
+
+// normalizeNumbers fills in the E.164 forms of a call's ANI and DNIS using the tenant's
+// region. Unparseable numbers are left blank and flagged invalid, or rejected if the tenant
+// requires it.
+func normalizeNumbers(ctx context.Context, call *db.Call) error {
+	tenant, err := db.TenantFromCtx(ctx)
+	if err != nil {
+		return errors.WithGrpcStatus(err, codes.Unauthenticated)
+	}
+
+	for _, n := range []struct {
+		raw        string
+		normalized *string
+		invalid    *bool
+	}{
+		{call.ANI, &call.ANIE164, &call.ANIInvalid},
+		{call.DNIS, &call.DNISE164, &call.DNISInvalid},
+	} {
+		if n.raw == "" {
+			continue
+		}
+
+		e164, err := phone.Normalize(n.raw, tenant.Config.DefaultRegion)
+		if err != nil && tenant.Config.RejectInvalidNumbers {
+			return errors.WithGrpcStatus(err, codes.InvalidArgument)
+		}
+		*n.normalized, *n.invalid = e164, err != nil
+	}
+
+	return nil
+}
+
+// listLimit applies the default and maximum to a requested page size
+func listLimit(requested int32) int {
+	switch {
+	case requested <= 0:
+		return defaultCallsLimit
+	case requested > maxCallsLimit:
+		return maxCallsLimit
+	}
+	return int(requested)
+}
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/pb"
+	"github.com/caring/go-packages/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeCalls stores calls in memory, keeping the history of each normalized ANI and of each
+// party called
+type fakeCalls struct {
+	calls      []*db.Call
+	historyErr error
+}
+
+func (f *fakeCalls) Create(ctx context.Context, c *db.Call) error {
+	c.CreatedAt = time.Unix(int64(len(f.calls)+1)*100, 0)
+	f.calls = append(f.calls, c)
+	return nil
+}
+
+func (f *fakeCalls) Get(ctx context.Context, ID int64) (*db.Call, error) {
+	for _, c := range f.calls {
+		if c.ID == ID {
+			return c, nil
+		}
+	}
+	return nil, db.ErrNotFound
+}
+
+func (f *fakeCalls) ListByANI(ctx context.Context, aniE164 string, limit int) ([]*db.Call, error) {
+	return nil, nil
+}
+
+func (f *fakeCalls) CallerHistory(ctx context.Context, aniE164 string, exclude int64) (*db.CallerHistory, error) {
+	if f.historyErr != nil {
+		return nil, f.historyErr
+	}
+	h := &db.CallerHistory{}
+	for _, c := range f.calls {
+		party := c.ANIE164
+		if c.Direction == Outbound {
+			party = c.DNISE164
+		}
+		if party == aniE164 && c.ID != exclude {
+			h.Calls++
+			h.LastCallID, h.LastCallAt = c.ID, c.CreatedAt
+		}
+	}
+	return h, nil
+}
+
+func (f *fakeCalls) List(ctx context.Context, filter db.CallFilter, limit int) ([]*db.Call, error) {
+	return nil, nil
+}
+
+func (f *fakeCalls) SetLegalHold(ctx context.Context, ID int64, hold bool, reason string) error {
+	return nil
+}
+
+func TestCreateCall(t *testing.T) {
+	ctx := db.TenantToCtx(context.Background(), &db.Tenant{ID: "a", Config: db.TenantConfig{DefaultRegion: "US"}})
+	store := &fakeCalls{}
+	events := &fakeHoldEvents{events: []*db.Event{
+		{CallID: 1000, Type: ENQUEUE, Timestamp: 100, Meta: "sales"},
+		{CallID: 1000, Type: CONNECT, IdentityID: 7, Timestamp: 130},
+		{CallID: 1000, Type: TRANSFER_COMPLETED, IdentityID: 7, Timestamp: 160, Meta: `{"kind": "cold", "to_identity": 8}`},
+		{CallID: 1000, Type: DISCONNECT, Timestamp: 190},
+		{CallID: 1000, Type: DISPO, IdentityID: 8, Timestamp: 200, Meta: "sale"},
+	}}
+
+	// ensures that a first time caller has an empty history
+	resp, err := CreateCall(ctx, &pb.CallRequest{Call: &pb.Call{CallId: 1000, ANI: "(512) 555-1234"}}, store, events, &fakeBlocks{})
+	assert.NoError(t, err, "Expected no error")
+	assert.Equal(t, &pb.CallerHistory{}, resp.GetCallerHistory(), "Expected no earlier calls")
+
+	// ensures that a repeat caller gets the count, time, disposition and agent of their last call,
+	// who had it transferred to them
+	resp, err = CreateCall(ctx, &pb.CallRequest{Call: &pb.Call{CallId: 1001, ANI: "512-555-1234"}}, store, events, &fakeBlocks{})
+	assert.NoError(t, err, "Expected no error")
+	assert.Equal(t, &pb.CallerHistory{Calls: 1, LastCallId: 1000, LastCallAt: 100, LastDisposition: "sale", LastIdentityId: 8},
+		resp.GetCallerHistory(), "Expected the earlier call in the history")
+
+	// ensures that a call is stored without a history that cannot be read
+	store.historyErr = errors.New("lock wait timeout")
+	resp, err = CreateCall(ctx, &pb.CallRequest{Call: &pb.Call{CallId: 1004, ANI: "512-555-1234"}}, store, events, &fakeBlocks{})
+	assert.NoError(t, err, "Expected no error")
+	assert.Nil(t, resp.GetCallerHistory(), "Expected no history")
+	assert.Len(t, store.calls, 3, "Expected the call to be stored")
+	store.historyErr = nil
+
+	// ensures that an outbound call gets the history of the party called, not of the number
+	// it is placed from
+	resp, err = CreateCall(ctx, &pb.CallRequest{Call: &pb.Call{CallId: 1005, ANI: "(800) 555-0100", DNIS: "512-555-1234", Direction: Outbound}}, store, events, &fakeBlocks{})
+	assert.NoError(t, err, "Expected no error")
+	assert.Equal(t, int64(3), resp.GetCallerHistory().GetCalls(), "Expected the calls from the party called")
+
+	// ensures that a number which does not normalize is not looked up
+	resp, err = CreateCall(ctx, &pb.CallRequest{Call: &pb.Call{CallId: 1002, ANI: "anonymous"}}, store, events, &fakeBlocks{})
+	assert.NoError(t, err, "Expected no error")
+	assert.Nil(t, resp.GetCallerHistory(), "Expected no history")
+	assert.True(t, resp.GetANIInvalid(), "Expected the number to be flagged invalid")
+
+	// ensures that a missing number is not flagged invalid
+	resp, err = CreateCall(ctx, &pb.CallRequest{Call: &pb.Call{CallId: 1003, DNIS: "(800) 555-0100"}}, store, events, &fakeBlocks{})
+	assert.NoError(t, err, "Expected no error")
+	assert.False(t, resp.GetANIInvalid(), "Expected a missing number not to be flagged invalid")
+	assert.False(t, resp.GetDNISInvalid(), "Expected a normalized number not to be flagged invalid")
+}
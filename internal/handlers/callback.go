@@ -0,0 +1,297 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/pb"
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+// CALLBACK_REQUESTED is the event type of a caller asking to be called back, see CallbackEventTypes
+const CALLBACK_REQUESTED = "callback requested"
+
+// callback reasons
+const (
+	CallbackAbandoned = "abandoned"
+	CallbackRequested = "requested"
+)
+
+// callback statuses
+const (
+	CallbackPending   = "pending"
+	CallbackClaimed   = "claimed"
+	CallbackCompleted = "completed"
+	CallbackCancelled = "cancelled"
+)
+
+// CallbackCallStatus is the status of the outbound call placed to complete a callback
+const CallbackCallStatus = "callback"
+
+var callbackStatuses = map[string]bool{
+	CallbackPending:   true,
+	CallbackClaimed:   true,
+	CallbackCompleted: true,
+	CallbackCancelled: true,
+}
+
+type callbackCreator interface {
+	Create(context.Context, int64, string, int64) (bool, error)
+}
+
+type callbackMethods interface {
+	Get(context.Context, int64) (*db.Callback, error)
+	List(context.Context, string, int64, int) ([]*db.Callback, error)
+	Claim(context.Context, int64, int64, int64) error
+	Complete(context.Context, int64, int64, int64, *db.Call) error
+}
+
+// callbackScheduler schedules callbacks as events are recorded
+type callbackScheduler interface {
+	ScheduleAbandoned(context.Context, *db.Event) error
+	ScheduleRequested(context.Context, *db.Event) error
+}
+
+// CallbackMeta is the optional JSON meta of a callback requested event
+type CallbackMeta struct {
+	// NotBefore is the earliest time in unix seconds the caller wants to be called
+	NotBefore int64 `json:"not_before,omitempty"`
+}
+
+// CallbackScheduler creates callback tasks, due within the tenant's business hours
+type CallbackScheduler struct {
+	calls     callGetter
+	callbacks callbackCreator
+}
+
+// NewCallbackScheduler creates a CallbackScheduler that stores tasks in callbacks
+func NewCallbackScheduler(calls callGetter, callbacks callbackCreator) *CallbackScheduler {
+	return &CallbackScheduler{calls, callbacks}
+}
+
+// ScheduleAbandoned schedules a callback for a call whose caller abandoned, once the
+// tenant's callback delay has passed. Short abandons are not called back.
+func (s *CallbackScheduler) ScheduleAbandoned(ctx context.Context, e *db.Event) error {
+	tenant, err := db.TenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	call, err := s.calls.Get(ctx, e.CallID)
+	if err != nil {
+		return err
+	}
+	if call.Outcome != OutcomeAbandonedInQueue && call.Outcome != OutcomeAbandonedRinging {
+		return nil
+	}
+
+	after := e.Timestamp + int64(tenant.Config.CallbackDelayMinutes)*60
+	return s.schedule(ctx, tenant.Config, e.CallID, CallbackAbandoned, after)
+}
+
+// ScheduleRequested schedules the callback a caller asked for
+func (s *CallbackScheduler) ScheduleRequested(ctx context.Context, e *db.Event) error {
+	tenant, err := db.TenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	m, err := parseCallbackMeta(e)
+	if err != nil {
+		return err
+	}
+
+	after := e.Timestamp
+	if m.NotBefore > after {
+		after = m.NotBefore
+	}
+	return s.schedule(ctx, tenant.Config, e.CallID, CallbackRequested, after)
+}
+
+// schedule creates a callback due at the first open time at or after after
+func (s *CallbackScheduler) schedule(ctx context.Context, cfg db.TenantConfig, callID int64, reason string, after int64) error {
+	due, err := NextOpen(cfg, time.Unix(after, 0))
+	if err != nil {
+		return err
+	}
+	_, err = s.callbacks.Create(ctx, callID, reason, due.Unix())
+	return err
+}
+
+// parseCallbackMeta decodes the meta of a callback requested event, which may be empty
+func parseCallbackMeta(e *db.Event) (*CallbackMeta, error) {
+	m := &CallbackMeta{}
+	if e.Meta == "" {
+		return m, nil
+	}
+	if err := json.Unmarshal([]byte(e.Meta), m); err != nil {
+		return nil, errors.Wrap(err, "callback meta")
+	}
+	return m, nil
+}
+
+// SchedulesAbandonedCallback is an effect that schedules a callback once the caller has abandoned.
+// It relies on the call's outcome, so it must run after ClassifiesOutcome.
+func SchedulesAbandonedCallback(scheduler callbackScheduler) EventEffect {
+	return func(ctx context.Context, e *db.Event) error {
+		return scheduler.ScheduleAbandoned(ctx, e)
+	}
+}
+
+// CallbackEventTypes are the event types of callers asking to be called back
+func CallbackEventTypes(scheduler callbackScheduler) []*EventType {
+	return []*EventType{
+		{
+			Name: CALLBACK_REQUESTED,
+			Meta: MetaSchema{JSON: true},
+			Validate: func(e *db.Event) error {
+				_, err := parseCallbackMeta(e)
+				return err
+			},
+			Effects: []EventEffect{func(ctx context.Context, e *db.Event) error {
+				return scheduler.ScheduleRequested(ctx, e)
+			}},
+		},
+	}
+}
+
+func ListCallbacks(ctx context.Context, in *pb.ListCallbacksRequest, store callbackMethods) (*pb.CallbacksResponse, error) {
+	status := in.GetStatus()
+	if status == "" {
+		status = CallbackPending
+	}
+	if !callbackStatuses[status] {
+		return nil, errors.WithGrpcStatus(errors.New("unknown callback status - "+status), codes.InvalidArgument)
+	}
+
+	dueBefore := in.GetDueBefore()
+	if dueBefore <= 0 {
+		dueBefore = math.MaxInt64
+	}
+
+	callbacks, err := store.List(ctx, status, dueBefore, listLimit(in.GetLimit()))
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	resp := &pb.CallbacksResponse{Callbacks: make([]*pb.Callback, 0, len(callbacks))}
+	for _, c := range callbacks {
+		resp.Callbacks = append(resp.Callbacks, c.ToProto())
+	}
+	return resp, nil
+}
+
+// ClaimCallback assigns a pending callback to an agent. Claiming a callback again as the
+// agent who holds it succeeds.
+func ClaimCallback(ctx context.Context, in *pb.ClaimCallbackRequest, store callbackMethods) (*pb.Callback, error) {
+	if in.GetIdentityId() == 0 {
+		return nil, errors.WithGrpcStatus(errors.New("identity_id is required"), codes.InvalidArgument)
+	}
+
+	err := store.Claim(ctx, in.GetCallbackId(), in.GetIdentityId(), time.Now().Unix())
+	if err != nil && !errors.Is(err, db.ErrNoRowsAffected) {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+	claimed := err == nil
+
+	callback, err := store.Get(ctx, in.GetCallbackId())
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, errors.WithGrpcStatus(err, codes.NotFound)
+		}
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	if !claimed && (callback.Status != CallbackClaimed || callback.ClaimedBy != in.GetIdentityId()) {
+		return nil, errors.WithGrpcStatus(errors.New("callback is "+callback.Status), codes.FailedPrecondition)
+	}
+
+	return callback.ToProto(), nil
+}
+
+// CompleteCallback completes a claimed callback by creating the outbound call placed to
+// the caller, linked into the conversation of the original call. Completing a callback
+// again with the same call succeeds. The outbound call is screened against the do-not-call
+// list like any other outbound call, a rejected one is audited and the callback left claimed.
+func CompleteCallback(ctx context.Context, in *pb.CompleteCallbackRequest, store callbackMethods, calls callGetter, conversations conversationLinker, blocks blockMatcher) (*pb.Callback, error) {
+	if in.GetIdentityId() == 0 || in.GetCallId() == 0 {
+		return nil, errors.WithGrpcStatus(errors.New("identity_id and call_id are required"), codes.InvalidArgument)
+	}
+
+	callback, err := store.Get(ctx, in.GetCallbackId())
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, errors.WithGrpcStatus(err, codes.NotFound)
+		}
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	if callback.Status == CallbackCompleted && callback.OutboundCallID == in.GetCallId() {
+		return callback.ToProto(), nil
+	}
+	if callback.Status != CallbackClaimed || callback.ClaimedBy != in.GetIdentityId() {
+		return nil, errors.WithGrpcStatus(errors.New("callback is not claimed by the agent"), codes.FailedPrecondition)
+	}
+	if callback.ANI == "" {
+		return nil, errors.WithGrpcStatus(errors.New("the caller's number is no longer available"), codes.FailedPrecondition)
+	}
+
+	original, err := calls.Get(ctx, callback.CallID)
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	conversationID, err := conversationOf(ctx, original, calls, conversations)
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	// the outbound call is placed from the number the caller dialed
+	outbound := &db.Call{
+		ID:             in.GetCallId(),
+		SID:            in.GetSid(),
+		ConversationID: conversationID,
+		ANI:            original.DNIS,
+		DNIS:           original.ANI,
+		Direction:      Outbound,
+		Status:         CallbackCallStatus,
+	}
+	if err = normalizeNumbers(ctx, outbound); err != nil {
+		return nil, err
+	}
+
+	// the caller may have asked not to be called since they left the callback, in which case
+	// it stays claimed and the agent cannot complete it
+	block, number, err := screenCall(ctx, outbound, Outbound, blocks)
+	if err != nil {
+		return nil, err
+	}
+	if block != nil && block.Action == BlockReject {
+		return nil, rejectCall(ctx, outbound, block, number, blocks, codes.FailedPrecondition)
+	}
+	if block != nil {
+		outbound.BlockID, outbound.BlockAction = block.ID, block.Action
+	}
+
+	completedAt := time.Now().Unix()
+	if err = store.Complete(ctx, callback.ID, in.GetIdentityId(), completedAt, outbound); err != nil {
+		if errors.Is(err, db.ErrNoRowsAffected) {
+			return nil, errors.WithGrpcStatus(errors.New("callback is no longer claimed by the agent"), codes.FailedPrecondition)
+		}
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+	if block != nil {
+		err = blocks.RecordBlockedCall(ctx, &db.BlockedCall{CallID: outbound.ID, BlockID: block.ID, List: block.List, Action: block.Action}, number)
+		if err != nil {
+			return nil, errors.WithGrpcStatus(err, codes.Internal)
+		}
+	}
+
+	callback.Status, callback.CompletedAt, callback.OutboundCallID = CallbackCompleted, completedAt, outbound.ID
+	return callback.ToProto(), nil
+}
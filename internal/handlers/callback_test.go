@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeCallbacks struct {
+	callbacks map[int64]*db.Callback
+	created   map[int64]int64
+	outbound  *db.Call
+}
+
+func (f *fakeCallbacks) Create(ctx context.Context, callID int64, reason string, dueAt int64) (bool, error) {
+	f.created[callID] = dueAt
+	return true, nil
+}
+
+func (f *fakeCallbacks) Get(ctx context.Context, ID int64) (*db.Callback, error) {
+	c, ok := f.callbacks[ID]
+	if !ok {
+		return nil, db.ErrNotFound
+	}
+	copied := *c
+	return &copied, nil
+}
+
+func (f *fakeCallbacks) List(ctx context.Context, status string, dueBefore int64, limit int) ([]*db.Callback, error) {
+	return nil, nil
+}
+
+func (f *fakeCallbacks) Claim(ctx context.Context, ID, identityID, claimedAt int64) error {
+	c, ok := f.callbacks[ID]
+	if !ok || c.Status != CallbackPending {
+		return db.ErrNoRowsAffected
+	}
+	c.Status, c.ClaimedBy, c.ClaimedAt = CallbackClaimed, identityID, claimedAt
+	return nil
+}
+
+func (f *fakeCallbacks) Complete(ctx context.Context, ID, identityID, completedAt int64, outbound *db.Call) error {
+	c := f.callbacks[ID]
+	if c.Status != CallbackClaimed || c.ClaimedBy != identityID {
+		return db.ErrNoRowsAffected
+	}
+	c.Status, c.CompletedAt, c.OutboundCallID = CallbackCompleted, completedAt, outbound.ID
+	f.outbound = outbound
+	return nil
+}
+
+func TestNextOpen(t *testing.T) {
+	cfg := db.TenantConfig{
+		Timezone:      "America/Chicago",
+		BusinessHours: &db.BusinessHours{Open: "09:00", Close: "17:00"},
+	}
+	chicago, _ := time.LoadLocation("America/Chicago")
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want time.Time
+	}{
+		{"During hours", time.Date(2020, 6, 3, 10, 30, 0, 0, chicago), time.Date(2020, 6, 3, 10, 30, 0, 0, chicago)},
+		{"Before opening", time.Date(2020, 6, 3, 7, 0, 0, 0, chicago), time.Date(2020, 6, 3, 9, 0, 0, 0, chicago)},
+		{"After closing", time.Date(2020, 6, 3, 17, 0, 0, 0, chicago), time.Date(2020, 6, 4, 9, 0, 0, 0, chicago)},
+		{"Over the weekend", time.Date(2020, 6, 5, 18, 0, 0, 0, chicago), time.Date(2020, 6, 8, 9, 0, 0, 0, chicago)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NextOpen(cfg, tt.at.UTC())
+			assert.NoError(t, err, "Expected no error")
+			assert.True(t, tt.want.Equal(got), "Expected %s, got %s", tt.want, got)
+		})
+	}
+}
+
+func TestCallbackScheduler_scheduleAbandoned(t *testing.T) {
+	calls := &fakeConversationCalls{calls: map[int64]*db.Call{
+		1: {ID: 1, Outcome: OutcomeAbandonedInQueue},
+		2: {ID: 2, Outcome: OutcomeShortAbandon},
+	}}
+	callbacks := &fakeCallbacks{created: map[int64]int64{}}
+	scheduler := NewCallbackScheduler(calls, callbacks)
+	ctx := db.TenantToCtx(context.Background(), &db.Tenant{ID: "tenant-a", Config: db.TenantConfig{CallbackDelayMinutes: 5}})
+
+	// ensures that abandoned callers are scheduled after the delay and short abandons are not
+	assert.NoError(t, scheduler.ScheduleAbandoned(ctx, &db.Event{CallID: 1, Type: DISCONNECT, Timestamp: 1000}), "Expected no error")
+	assert.NoError(t, scheduler.ScheduleAbandoned(ctx, &db.Event{CallID: 2, Type: DISCONNECT, Timestamp: 1000}), "Expected no error")
+	assert.Equal(t, map[int64]int64{1: 1300}, callbacks.created, "Expected a single callback due after the delay")
+}
+
+func TestCompleteCallback(t *testing.T) {
+	calls := &fakeConversationCalls{calls: map[int64]*db.Call{
+		1: {ID: 1, ANI: "5125551234", DNIS: "8005550100"},
+	}}
+	store := &fakeCallbacks{callbacks: map[int64]*db.Callback{
+		10: {ID: 10, CallID: 1, Status: CallbackPending, ANI: "5125551234"},
+	}}
+	ctx := db.TenantToCtx(context.Background(), &db.Tenant{ID: "tenant-a", Config: db.TenantConfig{DefaultRegion: "US"}})
+	complete := &pb.CompleteCallbackRequest{CallbackId: 10, IdentityId: 7, CallId: 2}
+	blocks := &fakeBlocks{blocks: []*db.NumberBlock{
+		{ID: 1, List: DoNotCallList, Number: "+15125551234", Action: BlockReject},
+	}}
+
+	// ensures that only the agent holding a callback can complete it
+	_, err := CompleteCallback(ctx, complete, store, calls, calls, blocks)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Expected an unclaimed callback to fail")
+
+	_, err = ClaimCallback(ctx, &pb.ClaimCallbackRequest{CallbackId: 10, IdentityId: 7}, store)
+	assert.NoError(t, err, "Expected the claim to succeed")
+	_, err = ClaimCallback(ctx, &pb.ClaimCallbackRequest{CallbackId: 10, IdentityId: 8}, store)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Expected a second agent's claim to fail")
+
+	// ensures that a caller on the do-not-call list is not called back and the attempt is audited
+	_, err = CompleteCallback(ctx, complete, store, calls, calls, blocks)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Expected a rejected number to fail the completion")
+	assert.Nil(t, store.outbound, "Expected no outbound call to be stored")
+	assert.Equal(t, CallbackClaimed, store.callbacks[10].Status, "Expected the callback to stay claimed")
+	assert.Len(t, blocks.blocked, 1, "Expected the rejected attempt to be audited")
+	blocks.blocks[0].Action = BlockFlag
+
+	// ensures that the outbound call dials the caller within the original conversation
+	resp, err := CompleteCallback(ctx, complete, store, calls, calls, blocks)
+	assert.NoError(t, err, "Expected the completion to succeed")
+	assert.Equal(t, CallbackCompleted, resp.GetStatus(), "Expected the callback to be completed")
+	assert.Equal(t, int64(2), resp.GetOutboundCallId(), "Expected the outbound call on the callback")
+	assert.Equal(t, "+15125551234", store.outbound.DNISE164, "Expected the outbound call to dial the caller")
+	assert.Equal(t, int64(1), store.outbound.ConversationID, "Expected the outbound call in the original conversation")
+	assert.Equal(t, int64(1), calls.calls[1].ConversationID, "Expected the original call to start the conversation")
+	assert.Equal(t, Outbound, store.outbound.Direction, "Expected the outbound call to be stored as outbound")
+	assert.Equal(t, BlockFlag, store.outbound.BlockAction, "Expected the flag to be stored on the outbound call")
+	assert.Len(t, blocks.blocked, 2, "Expected the flagged call to be audited")
+
+	_, err = CompleteCallback(ctx, complete, store, calls, calls, blocks)
+	assert.NoError(t, err, "Expected completing again with the same call to succeed")
+}
@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/pb"
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+type cdrMethods interface {
+	Create(context.Context, *db.CDR) (bool, error)
+	Get(context.Context, int64) (*db.CDR, error)
+}
+
+type cdrEventMethods interface {
+	ListByCall(context.Context, int64) ([]*db.Event, error)
+}
+
+// callCompleter finalizes a call once its events show it has completed
+type callCompleter interface {
+	Complete(context.Context, int64) (*db.CDR, error)
+}
+
+// Completer assembles and stores the CDR of a call once it has been both disconnected
+// and dispositioned, and publishes each CDR the first time it is stored
+type Completer struct {
+	calls   callGetter
+	events  cdrEventMethods
+	cdrs    cdrMethods
+	publish func(*db.CDR)
+}
+
+// NewCompleter creates a Completer that hands newly stored CDRs to publish
+func NewCompleter(calls callGetter, events cdrEventMethods, cdrs cdrMethods, publish func(*db.CDR)) *Completer {
+	return &Completer{calls, events, cdrs, publish}
+}
+
+// Complete stores the CDR of a call if it has completed. It returns nil without error
+// while the call is still in progress or when its CDR was already stored.
+func (c *Completer) Complete(ctx context.Context, callID int64) (*db.CDR, error) {
+	events, err := c.events.ListByCall(ctx, callID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isComplete(events) {
+		return nil, nil
+	}
+
+	call, err := c.calls.Get(ctx, callID)
+	if err != nil {
+		return nil, err
+	}
+
+	cdr := NewCDR(call, events)
+	created, err := c.cdrs.Create(ctx, cdr)
+	if err != nil || !created {
+		return nil, err
+	}
+
+	c.publish(cdr)
+	return cdr, nil
+}
+
+// isComplete reports whether a call has been both disconnected and dispositioned
+func isComplete(events []*db.Event) bool {
+	var disconnected, dispositioned bool
+	for _, e := range events {
+		switch e.Type {
+		case DISCONNECT:
+			disconnected = true
+		case DISPO:
+			dispositioned = true
+		}
+	}
+	return disconnected && dispositioned
+}
+
+// NewCDR assembles the CDR of a call from its events
+func NewCDR(call *db.Call, events []*db.Event) *db.CDR {
+	m := ComputeMetrics(events)
+	cdr := &db.CDR{
+		CallID:          call.ID,
+		TenantID:        call.TenantID,
+		ConversationID:  call.ConversationID,
+		DNIS:            call.DNIS,
+		DNISE164:        call.DNISE164,
+		Parties:         []int64{},
+		StartedAt:       m.StartedAt,
+		EnqueuedAt:      m.EnqueuedAt,
+		RingingAt:       m.RingingAt,
+		ConnectedAt:     m.ConnectedAt,
+		DisconnectedAt:  m.DisconnectedAt,
+		QueueSeconds:    m.QueueSeconds,
+		RingSeconds:     m.RingSeconds,
+		TalkSeconds:     m.TalkSeconds,
+		DurationSeconds: m.DurationSeconds,
+		Disposition:     m.Disposition,
+	}
+
+	seen := map[int64]bool{}
+	party := func(identity int64) {
+		if identity != 0 && !seen[identity] {
+			seen[identity] = true
+			cdr.Parties = append(cdr.Parties, identity)
+		}
+	}
+
+	for _, e := range events {
+		switch e.Type {
+		case CONNECT, JOIN:
+			party(e.IdentityID)
+		case TRANSFER_CONSULTED, TRANSFER_COMPLETED:
+			if t, err := ParseTransferMeta(e); err == nil {
+				party(t.ToIdentity)
+			}
+		case ENQUEUE:
+			if cdr.Queue == "" {
+				cdr.Queue = e.Meta
+			}
+		case DISCONNECT:
+			if e.Timestamp == m.DisconnectedAt {
+				cdr.DisconnectCause = e.Meta
+			}
+		case DISPO:
+			if e.Timestamp >= cdr.DispositionedAt {
+				cdr.DispositionedAt = e.Timestamp
+			}
+		}
+	}
+
+	return cdr
+}
+
+func GetCDR(ctx context.Context, in *pb.CDRRequest, store cdrMethods) (*pb.CDR, error) {
+	cdr, err := store.Get(ctx, in.GetCallId())
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, errors.WithGrpcStatus(err, codes.NotFound)
+		}
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	return &pb.CDR{
+		CallId:          cdr.CallID,
+		ConversationId:  cdr.ConversationID,
+		DNIS:            cdr.DNIS,
+		DNISE164:        cdr.DNISE164,
+		ANIHash:         cdr.ANIHash,
+		Parties:         cdr.Parties,
+		StartedAt:       cdr.StartedAt,
+		EnqueuedAt:      cdr.EnqueuedAt,
+		RingingAt:       cdr.RingingAt,
+		ConnectedAt:     cdr.ConnectedAt,
+		DisconnectedAt:  cdr.DisconnectedAt,
+		DispositionedAt: cdr.DispositionedAt,
+		QueueSeconds:    cdr.QueueSeconds,
+		RingSeconds:     cdr.RingSeconds,
+		TalkSeconds:     cdr.TalkSeconds,
+		DurationSeconds: cdr.DurationSeconds,
+		Queue:           cdr.Queue,
+		Disposition:     cdr.Disposition,
+		DisconnectCause: cdr.DisconnectCause,
+		CreatedAt:       cdr.CreatedAt.Unix(),
+		DNISHash:        cdr.DNISHash,
+	}, nil
+}
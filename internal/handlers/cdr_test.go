@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeCDRStore struct {
+	call    *db.Call
+	events  []*db.Event
+	created map[int64]*db.CDR
+}
+
+func (f *fakeCDRStore) Get(ctx context.Context, ID int64) (*db.Call, error) {
+	return f.call, nil
+}
+
+func (f *fakeCDRStore) ListByCall(ctx context.Context, callID int64) ([]*db.Event, error) {
+	return f.events, nil
+}
+
+type fakeCDRs struct {
+	created map[int64]*db.CDR
+}
+
+func (f *fakeCDRs) Create(ctx context.Context, cdr *db.CDR) (bool, error) {
+	if _, ok := f.created[cdr.CallID]; ok {
+		return false, nil
+	}
+	f.created[cdr.CallID] = cdr
+	return true, nil
+}
+
+func (f *fakeCDRs) Get(ctx context.Context, callID int64) (*db.CDR, error) {
+	return f.created[callID], nil
+}
+
+func TestCompleter_complete(t *testing.T) {
+	store := &fakeCDRStore{call: &db.Call{ID: 1000, DNISE164: "+18005550100"}}
+	cdrs := &fakeCDRs{created: map[int64]*db.CDR{}}
+	published := []*db.CDR{}
+	completer := NewCompleter(store, store, cdrs, func(c *db.CDR) { published = append(published, c) })
+
+	// ensures that a call which has not been dispositioned is left open
+	t.Run("In progress", func(t *testing.T) {
+		store.events = []*db.Event{
+			{CallID: 1000, Type: ENQUEUE, Timestamp: 100, Meta: "sales"},
+			{CallID: 1000, Type: CONNECT, IdentityID: 7, Timestamp: 130},
+			{CallID: 1000, Type: DISCONNECT, Timestamp: 190, Meta: "caller hung up"},
+		}
+
+		cdr, err := completer.Complete(context.Background(), 1000)
+		assert.NoError(t, err, "Expected no error")
+		assert.Nil(t, cdr, "Expected no CDR")
+		assert.Empty(t, published, "Expected nothing published")
+	})
+
+	// ensures that a disconnected and dispositioned call is finalized and published
+	t.Run("Completed", func(t *testing.T) {
+		store.events = append(store.events,
+			&db.Event{CallID: 1000, Type: JOIN, IdentityID: 8, Timestamp: 150},
+			&db.Event{CallID: 1000, Type: DISPO, IdentityID: 7, Timestamp: 200, Meta: "sale"},
+		)
+
+		cdr, err := completer.Complete(context.Background(), 1000)
+		assert.NoError(t, err, "Expected no error")
+		if ok := assert.NotNil(t, cdr, "Expected a CDR"); !ok {
+			assert.FailNow(t, "no CDR")
+		}
+		assert.Equal(t, []int64{7, 8}, cdr.Parties, "Expected every connected party")
+		assert.Equal(t, "sales", cdr.Queue, "Expected the queue")
+		assert.Equal(t, "caller hung up", cdr.DisconnectCause, "Expected the disconnect cause")
+		assert.Equal(t, "sale", cdr.Disposition, "Expected the disposition")
+		assert.Equal(t, int64(200), cdr.DispositionedAt, "Expected the disposition time")
+		assert.Equal(t, int64(60), cdr.TalkSeconds, "Expected the talk time")
+		assert.Len(t, published, 1, "Expected the CDR to be published")
+	})
+
+	// ensures that a call is only finalized once
+	t.Run("Already completed", func(t *testing.T) {
+		cdr, err := completer.Complete(context.Background(), 1000)
+		assert.NoError(t, err, "Expected no error")
+		assert.Nil(t, cdr, "Expected no new CDR")
+		assert.Len(t, published, 1, "Expected the CDR to be published once")
+	})
+}
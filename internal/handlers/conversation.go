@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/pb"
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+// conversation statuses
+const (
+	ConversationActive    = "active"
+	ConversationCompleted = "completed"
+)
+
+type conversationMethods interface {
+	Get(context.Context, int64) (*db.Conversation, error)
+	Link(context.Context, int64, int64) error
+	Unlink(context.Context, int64, int64) error
+}
+
+type callGetter interface {
+	Get(context.Context, int64) (*db.Call, error)
+}
+
+// ConversationMetrics summarize every call of a conversation
+type ConversationMetrics struct {
+	StartedAt       int64
+	EndedAt         int64
+	DurationSeconds int64
+	QueueSeconds    int64
+	TalkSeconds     int64
+	CallCount       int
+	EventCount      int
+}
+
+// ConversationStatus is active while any call of the conversation has not disconnected
+func ConversationStatus(c *db.Conversation) string {
+	disconnected := map[int64]bool{}
+	for _, e := range c.Events {
+		if e.Type == DISCONNECT {
+			disconnected[e.CallID] = true
+		}
+	}
+	for _, call := range c.Calls {
+		if !disconnected[call.ID] {
+			return ConversationActive
+		}
+	}
+	return ConversationCompleted
+}
+
+// ComputeConversationMetrics derives a conversation's metrics from the metrics of each of its calls
+func ComputeConversationMetrics(c *db.Conversation) *ConversationMetrics {
+	m := &ConversationMetrics{CallCount: len(c.Calls), EventCount: len(c.Events)}
+
+	byCall := map[int64][]*db.Event{}
+	for _, e := range c.Events {
+		byCall[e.CallID] = append(byCall[e.CallID], e)
+	}
+
+	var end int64
+	for _, call := range c.Calls {
+		cm := ComputeMetrics(byCall[call.ID])
+		if cm.StartedAt != 0 && (m.StartedAt == 0 || cm.StartedAt < m.StartedAt) {
+			m.StartedAt = cm.StartedAt
+		}
+		if cm.DisconnectedAt > end {
+			end = cm.DisconnectedAt
+		}
+		m.QueueSeconds += cm.QueueSeconds
+		m.TalkSeconds += cm.TalkSeconds
+	}
+
+	if ConversationStatus(c) == ConversationCompleted {
+		m.EndedAt = end
+		m.DurationSeconds = span(m.StartedAt, end)
+	}
+
+	return m
+}
+
+func GetConversation(ctx context.Context, in *pb.ConversationRequest, store conversationMethods) (*pb.ConversationResponse, error) {
+	// 0 is the unset default and never a conversation id
+	if in.GetConversationId() == 0 {
+		return nil, errors.WithGrpcStatus(errors.New("conversation_id is required"), codes.InvalidArgument)
+	}
+
+	c, err := store.Get(ctx, in.GetConversationId())
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, errors.WithGrpcStatus(err, codes.NotFound)
+		}
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	m := ComputeConversationMetrics(c)
+	resp := &pb.ConversationResponse{
+		ConversationId: c.ID,
+		Status:         ConversationStatus(c),
+		Calls:          make([]*pb.CallResponse, 0, len(c.Calls)),
+		Timeline:       make([]*pb.EventResponse, 0, len(c.Events)),
+		Metrics: &pb.ConversationMetrics{
+			StartedAt:       m.StartedAt,
+			EndedAt:         m.EndedAt,
+			DurationSeconds: m.DurationSeconds,
+			QueueSeconds:    m.QueueSeconds,
+			TalkSeconds:     m.TalkSeconds,
+			CallCount:       int32(m.CallCount),
+			EventCount:      int32(m.EventCount),
+		},
+	}
+	for _, call := range c.Calls {
+		resp.Calls = append(resp.Calls, call.ToProto())
+	}
+	for _, e := range c.Events {
+		resp.Timeline = append(resp.Timeline, e.ToProto())
+	}
+
+	return resp, nil
+}
+
+func LinkCall(ctx context.Context, in *pb.ConversationCallRequest, store conversationMethods, calls callGetter) (*pb.CallResponse, error) {
+	if in.GetConversationId() == 0 {
+		return nil, errors.WithGrpcStatus(errors.New("conversation_id is required"), codes.InvalidArgument)
+	}
+
+	call, err := calls.Get(ctx, in.GetCallId())
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, errors.WithGrpcStatus(err, codes.NotFound)
+		}
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	// linking is idempotent, and MySQL reports no affected rows for an unchanged call
+	if call.ConversationID == in.GetConversationId() {
+		return call.ToProto(), nil
+	}
+
+	if err = store.Link(ctx, in.GetConversationId(), call.ID); err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	call.ConversationID = in.GetConversationId()
+	return call.ToProto(), nil
+}
+
+func UnlinkCall(ctx context.Context, in *pb.ConversationCallRequest, store conversationMethods, calls callGetter) (*pb.CallResponse, error) {
+	if err := store.Unlink(ctx, in.GetConversationId(), in.GetCallId()); err != nil {
+		if errors.Is(err, db.ErrNoRowsAffected) {
+			return nil, errors.WithGrpcStatus(errors.New("call is not part of the conversation"), codes.NotFound)
+		}
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	call, err := calls.Get(ctx, in.GetCallId())
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	return call.ToProto(), nil
+}
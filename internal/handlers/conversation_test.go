@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/pb"
+)
+
+// fakeConversations fails the test if it is reached
+type fakeConversations struct {
+	t *testing.T
+}
+
+func (f *fakeConversations) Get(ctx context.Context, ID int64) (*db.Conversation, error) {
+	f.t.Errorf("Expected no lookup of conversation %d", ID)
+	return nil, db.ErrNotFound
+}
+
+func (f *fakeConversations) Link(ctx context.Context, ID, callID int64) error { return nil }
+
+func (f *fakeConversations) Unlink(ctx context.Context, ID, callID int64) error { return nil }
+
+func TestGetConversation_requiresID(t *testing.T) {
+	// ensures that conversation 0, which unlinked calls hold, is not looked up
+	_, err := GetConversation(context.Background(), &pb.ConversationRequest{}, &fakeConversations{t})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "Expected a missing conversation_id to be invalid")
+}
+
+func TestComputeConversationMetrics(t *testing.T) {
+	c := &db.Conversation{
+		ID:    500,
+		Calls: []*db.Call{{ID: 1}, {ID: 2}},
+		Events: []*db.Event{
+			{CallID: 1, Type: ENQUEUE, Timestamp: 1000},
+			{CallID: 1, Type: CONNECT, Timestamp: 1020},
+			{CallID: 1, Type: DISCONNECT, Timestamp: 1100},
+			{CallID: 2, Type: DIAL, Timestamp: 1100},
+			{CallID: 2, Type: CONNECT, Timestamp: 1110},
+		},
+	}
+
+	// ensures that a conversation with a live call is active and has no end
+	t.Run("Active", func(t *testing.T) {
+		m := ComputeConversationMetrics(c)
+		assert.Equal(t, ConversationActive, ConversationStatus(c), "Expected an active conversation")
+		assert.Equal(t, int64(1000), m.StartedAt, "Expected the first call's start")
+		assert.Equal(t, int64(0), m.EndedAt, "Expected no end")
+		assert.Equal(t, int64(20), m.QueueSeconds, "Expected queue time summed across calls")
+		assert.Equal(t, 2, m.CallCount, "Expected both calls")
+	})
+
+	// ensures that a conversation ends when its last call disconnects
+	t.Run("Completed", func(t *testing.T) {
+		c.Events = append(c.Events, &db.Event{CallID: 2, Type: DISCONNECT, Timestamp: 1200})
+
+		m := ComputeConversationMetrics(c)
+		assert.Equal(t, ConversationCompleted, ConversationStatus(c), "Expected a completed conversation")
+		assert.Equal(t, int64(1200), m.EndedAt, "Expected the last disconnect")
+		assert.Equal(t, int64(200), m.DurationSeconds, "Expected the span of every call")
+		assert.Equal(t, int64(170), m.TalkSeconds, "Expected talk time summed across calls")
+	})
+}
@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/internal/phone"
+	"github.com/caring/call-handling/pb"
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+type erasureMethods interface {
+	EraseCaller(context.Context, string, string, string) (*db.ErasureReceipt, error)
+}
+
+// EraseCaller anonymizes every call from a number. Only tenant admins may erase callers.
+func EraseCaller(ctx context.Context, in *pb.EraseCallerRequest, store erasureMethods) (*pb.ErasureReceipt, error) {
+	tenant, err := db.TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Unauthenticated)
+	}
+
+	if err = requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if in.GetRequestedBy() == "" {
+		return nil, errors.WithGrpcStatus(errors.New("requested_by is required"), codes.InvalidArgument)
+	}
+
+	number, err := phone.Normalize(in.GetNumber(), tenant.Config.DefaultRegion)
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.InvalidArgument)
+	}
+
+	receipt, err := store.EraseCaller(ctx, number, in.GetRequestedBy(), in.GetReference())
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	return &pb.ErasureReceipt{
+		ReceiptId:    receipt.ID,
+		RequestedBy:  receipt.RequestedBy,
+		Reference:    receipt.Reference,
+		CallsErased:  receipt.CallsErased,
+		EventsErased: receipt.EventsErased,
+		CallsHeld:    receipt.CallsHeld,
+		CreatedAt:    receipt.CreatedAt.Unix(),
+	}, nil
+}
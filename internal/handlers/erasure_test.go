@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/pb"
+)
+
+// fakeErasures records the numbers erased
+type fakeErasures struct {
+	erased []string
+}
+
+func (f *fakeErasures) EraseCaller(ctx context.Context, aniE164, requestedBy, reference string) (*db.ErasureReceipt, error) {
+	f.erased = append(f.erased, aniE164)
+	return &db.ErasureReceipt{ID: 1, RequestedBy: requestedBy, Reference: reference}, nil
+}
+
+func TestEraseCaller(t *testing.T) {
+	ctx := db.TenantToCtx(context.Background(), &db.Tenant{ID: "a", Config: db.TenantConfig{DefaultRegion: "US"}})
+	in := &pb.EraseCallerRequest{Number: "(512) 555-1234", RequestedBy: "privacy@caring.com"}
+
+	// ensures that a caller without a role cannot erase
+	store := &fakeErasures{}
+	_, err := EraseCaller(ctx, in, store)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "Expected a missing role to be unauthenticated")
+
+	// ensures that only admins can erase
+	_, err = EraseCaller(context.WithValue(ctx, roleKey{}, "agent"), in, store)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "Expected a non admin to be denied")
+	assert.Empty(t, store.erased, "Expected nothing erased")
+
+	// ensures that an admin erases the normalized number
+	_, err = EraseCaller(context.WithValue(ctx, roleKey{}, AdminRole), in, store)
+	assert.NoError(t, err, "Expected no error")
+	assert.Equal(t, []string{"+15125551234"}, store.erased, "Expected the normalized number erased")
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/caring/go-packages/pkg/errors"
 )
 
+// names of the builtin event types, see BuiltinEventTypes
 const (
 	DIAL       = "dialing"
 	RING       = "ringing"
@@ -23,49 +24,131 @@ const (
 
 type eventMethods interface {
 	Create(context.Context, *db.Event) error
+	CreateChecked(context.Context, *db.Event, func([]*db.Event) error) error
 }
 
-func Dialed(ctx context.Context, in *pb.EventRequest, store eventMethods) (*pb.EventResponse, error) {
-	return createEvent(ctx, in, store, DIAL)
+type protoEvent interface {
+	GetEvent() *pb.Event
 }
 
-func Ringed(ctx context.Context, in *pb.EventRequest, store eventMethods) (*pb.EventResponse, error) {
-	return createEvent(ctx, in, store, RING)
+func Dialed(ctx context.Context, in *pb.EventRequest, store eventMethods, registry *EventRegistry) (*pb.EventResponse, error) {
+	return recordLegacyEvent(ctx, in, store, registry, DIAL)
 }
 
-func Connected(ctx context.Context, in *pb.EventRequest, store eventMethods) (*pb.EventResponse, error) {
-	return createEvent(ctx, in, store, CONNECT)
+func Ringed(ctx context.Context, in *pb.EventRequest, store eventMethods, registry *EventRegistry) (*pb.EventResponse, error) {
+	return recordLegacyEvent(ctx, in, store, registry, RING)
 }
 
-func Disconnected(ctx context.Context, in *pb.EventRequest, store eventMethods) (*pb.EventResponse, error) {
-	return createEvent(ctx, in, store, DISCONNECT)
+func Connected(ctx context.Context, in *pb.EventRequest, store eventMethods, registry *EventRegistry) (*pb.EventResponse, error) {
+	return recordLegacyEvent(ctx, in, store, registry, CONNECT)
 }
 
-func Joined(ctx context.Context, in *pb.EventRequest, store eventMethods) (*pb.EventResponse, error) {
-	return createEvent(ctx, in, store, JOIN)
+func Disconnected(ctx context.Context, in *pb.EventRequest, store eventMethods, registry *EventRegistry) (*pb.EventResponse, error) {
+	return recordLegacyEvent(ctx, in, store, registry, DISCONNECT)
 }
 
-func Exited(ctx context.Context, in *pb.EventRequest, store eventMethods) (*pb.EventResponse, error) {
-	return createEvent(ctx, in, store, EXIT)
+func Joined(ctx context.Context, in *pb.EventRequest, store eventMethods, registry *EventRegistry) (*pb.EventResponse, error) {
+	return recordLegacyEvent(ctx, in, store, registry, JOIN)
 }
 
-func Dispositioned(ctx context.Context, in *pb.EventRequest, store eventMethods) (*pb.EventResponse, error) {
-	return createEvent(ctx, in, store, DISPO)
+func Exited(ctx context.Context, in *pb.EventRequest, store eventMethods, registry *EventRegistry) (*pb.EventResponse, error) {
+	return recordLegacyEvent(ctx, in, store, registry, EXIT)
 }
 
-func Enqueued(ctx context.Context, in *pb.EventRequest, store eventMethods) (*pb.EventResponse, error) {
-	return createEvent(ctx, in, store, ENQUEUE)
+func Dispositioned(ctx context.Context, in *pb.EventRequest, store eventMethods, registry *EventRegistry) (*pb.EventResponse, error) {
+	return recordLegacyEvent(ctx, in, store, registry, DISPO)
 }
 
-func createEvent(ctx context.Context, in *pb.EventRequest, store eventMethods, eventType string) (resp *pb.EventResponse, err error) {
-	var (
-		event *db.Event
-	)
-	event = db.NewEvent(in, eventType)
-	err = store.Create(ctx, event)
+func Enqueued(ctx context.Context, in *pb.EventRequest, store eventMethods, registry *EventRegistry) (*pb.EventResponse, error) {
+	return recordLegacyEvent(ctx, in, store, registry, ENQUEUE)
+}
+
+// RecordEvent records an event of any registered type, validating it against its type
+// and running the type's side effects once it is stored
+func RecordEvent(ctx context.Context, in *pb.EventRequest, store eventMethods, registry *EventRegistry) (*pb.EventResponse, error) {
+	return recordEvent(ctx, in, store, registry, in.GetEvent().GetEventType())
+}
+
+func recordEvent(ctx context.Context, in *pb.EventRequest, store eventMethods, registry *EventRegistry, eventType string) (*pb.EventResponse, error) {
+	event, t, err := checkEvent(in, registry, eventType)
 	if err != nil {
-		err = errors.WithGrpcStatus(err, codes.Internal)
+		return nil, err
+	}
+
+	return storeEvent(ctx, store, registry, t, event)
+}
+
+// recordLegacyEvent records an event through one of the RPCs dedicated to a builtin type.
+// Those RPCs predate event type schemas, so they accept what they always have and only
+// RecordEvent validates events against their type.
+func recordLegacyEvent(ctx context.Context, in *pb.EventRequest, store eventMethods, registry *EventRegistry, eventType string) (*pb.EventResponse, error) {
+	t, ok := registry.Lookup(eventType)
+	if !ok {
+		return nil, errors.WithGrpcStatus(errors.New("unknown event type - "+eventType), codes.InvalidArgument)
+	}
+
+	return storeEvent(ctx, store, registry, t, db.NewEvent(in, t.Name))
+}
+
+// storeEvent stores a checked event and runs its type's side effects
+func storeEvent(ctx context.Context, store eventMethods, registry *EventRegistry, t *EventType, event *db.Event) (*pb.EventResponse, error) {
+	if err := createEvent(ctx, store, t, event); err != nil {
+		return nil, err
+	}
+
+	runEffects(ctx, registry, t, event)
+	return event.ToProto(), nil
+}
+
+// checkEvent builds an event of a registered type and validates it against that type
+func checkEvent(in protoEvent, registry *EventRegistry, eventType string) (*db.Event, *EventType, error) {
+	t, ok := registry.Lookup(eventType)
+	if !ok {
+		return nil, nil, errors.WithGrpcStatus(errors.New("unknown event type - "+eventType), codes.InvalidArgument)
+	}
+
+	event := db.NewEvent(in, t.Name)
+	if event.CallID == 0 {
+		return nil, nil, errors.WithGrpcStatus(errors.New("call_id is required"), codes.InvalidArgument)
+	}
+	if err := t.check(event); err != nil {
+		return nil, nil, errors.WithGrpcStatus(err, codes.InvalidArgument)
+	}
+
+	return event, t, nil
+}
+
+// createEvent stores an event, checking it against the recorded events of its call when
+// its type has a precondition
+func createEvent(ctx context.Context, store eventMethods, t *EventType, event *db.Event) error {
+	if t.Precondition == nil {
+		if err := store.Create(ctx, event); err != nil {
+			return errors.WithGrpcStatus(err, codes.Internal)
+		}
+		return nil
+	}
+
+	err := store.CreateChecked(ctx, event, func(recorded []*db.Event) error {
+		return t.Precondition(ctx, event, recorded)
+	})
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, ErrPrecondition):
+		return errors.WithGrpcStatus(err, codes.FailedPrecondition)
+	case errors.Is(err, db.ErrNotFound):
+		return errors.WithGrpcStatus(err, codes.NotFound)
+	default:
+		return errors.WithGrpcStatus(err, codes.Internal)
+	}
+}
+
+// runEffects runs every side effect of a recorded event, reporting those that fail to the
+// registry rather than to the client, which would otherwise retry and record the event twice
+func runEffects(ctx context.Context, registry *EventRegistry, t *EventType, event *db.Event) {
+	for _, effect := range t.Effects {
+		if err := effect(ctx, event); err != nil {
+			registry.effectFailed(ctx, event, err)
+		}
 	}
-	resp = event.ToProto()
-	return
 }
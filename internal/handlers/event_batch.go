@@ -0,0 +1,191 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/internal/redact"
+	"github.com/caring/call-handling/pb"
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+const (
+	// eventBatchSize is the number of events written per multi-row insert
+	eventBatchSize = 500
+	// maxEventBatch is the number of events a single RecordEventBatch request may carry
+	maxEventBatch = 5000
+)
+
+type eventBatchMethods interface {
+	eventMethods
+	CreateBatch(context.Context, []*db.Event) error
+}
+
+// RecordEventBatch records a batch of events of any registered type. Each event is
+// validated on its own and reported in the results, so one bad event does not fail the batch.
+func RecordEventBatch(ctx context.Context, in *pb.EventBatchRequest, store eventBatchMethods, registry *EventRegistry) (*pb.EventsResponse, error) {
+	if len(in.GetEvents()) > maxEventBatch {
+		return nil, errors.WithGrpcStatus(errors.New("batches are limited to "+strconv.Itoa(maxEventBatch)+" events"), codes.InvalidArgument)
+	}
+
+	b := newEventBatcher(store, registry, nil)
+	for _, e := range in.GetEvents() {
+		b.add(ctx, e)
+	}
+
+	b.flush(ctx)
+	sortResults(b.resp)
+	return b.resp, nil
+}
+
+// RecordEvents is the streaming counterpart of RecordEventBatch. It writes events in chunks
+// as they arrive and replies with the results of each chunk once it is written, so results
+// are not held for the life of the stream.
+func RecordEvents(stream pb.Callhandling_RecordEventsServer, store eventBatchMethods, registry *EventRegistry) error {
+	ctx := stream.Context()
+
+	b := newEventBatcher(store, registry, stream.Send)
+	for {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err = b.add(ctx, in.GetEvent()); err != nil {
+			return err
+		}
+	}
+
+	return b.flush(ctx)
+}
+
+// eventBatcher validates events as they are added and writes them in chunks, recording
+// the outcome of each event by its position
+type eventBatcher struct {
+	store    eventBatchMethods
+	registry *EventRegistry
+	resp     *pb.EventsResponse
+	// send, when set, is handed the results collected so far each time a chunk is written
+	send func(*pb.EventsResponse) error
+
+	next    int32
+	pending []pendingEvent
+}
+
+type pendingEvent struct {
+	index int32
+	event *db.Event
+	t     *EventType
+}
+
+func newEventBatcher(store eventBatchMethods, registry *EventRegistry, send func(*pb.EventsResponse) error) *eventBatcher {
+	return &eventBatcher{store: store, registry: registry, resp: newEventsResponse(), send: send}
+}
+
+func newEventsResponse() *pb.EventsResponse {
+	return &pb.EventsResponse{Results: []*pb.EventResult{}}
+}
+
+// add validates an event and queues it, writing the queue once it is full
+func (b *eventBatcher) add(ctx context.Context, in *pb.Event) error {
+	index := b.next
+	b.next++
+
+	event, t, err := checkEvent(&pb.EventRequest{Event: in}, b.registry, in.GetEventType())
+	if err != nil {
+		b.result(index, nil, err)
+		if len(b.resp.Results) >= eventBatchSize {
+			return b.flush(ctx)
+		}
+		return nil
+	}
+
+	// preconditions depend on the events before this one, so those are written first and the
+	// event is then checked and stored on its own
+	if t.Precondition != nil {
+		if err = b.flush(ctx); err != nil {
+			return err
+		}
+		if err = createEvent(ctx, b.store, t, event); err != nil {
+			b.result(index, nil, err)
+			return nil
+		}
+		runEffects(ctx, b.registry, t, event)
+		b.result(index, event, nil)
+		return nil
+	}
+
+	b.pending = append(b.pending, pendingEvent{index, event, t})
+	if len(b.pending) >= eventBatchSize {
+		return b.flush(ctx)
+	}
+	return nil
+}
+
+// flush writes the queued events, runs their side effects and sends the results collected
+// so far. A chunk that fails to insert is retried an event at a time, so that each event
+// gets its own result.
+func (b *eventBatcher) flush(ctx context.Context) error {
+	if len(b.pending) > 0 {
+		events := make([]*db.Event, len(b.pending))
+		for i, p := range b.pending {
+			events[i] = p.event
+		}
+
+		if err := b.store.CreateBatch(ctx, events); err != nil {
+			for _, p := range b.pending {
+				if err = b.store.Create(ctx, p.event); err != nil {
+					b.result(p.index, nil, errors.WithGrpcStatus(err, codes.Internal))
+					continue
+				}
+				runEffects(ctx, b.registry, p.t, p.event)
+				b.result(p.index, p.event, nil)
+			}
+		} else {
+			for _, p := range b.pending {
+				runEffects(ctx, b.registry, p.t, p.event)
+				b.result(p.index, p.event, nil)
+			}
+		}
+
+		b.pending = b.pending[:0]
+	}
+
+	if b.send == nil || len(b.resp.Results) == 0 {
+		return nil
+	}
+
+	resp := b.resp
+	b.resp = newEventsResponse()
+	sortResults(resp)
+	return b.send(resp)
+}
+
+// sortResults orders results by the position of their event
+func sortResults(resp *pb.EventsResponse) {
+	sort.Slice(resp.Results, func(i, j int) bool { return resp.Results[i].Index < resp.Results[j].Index })
+}
+
+// result records the outcome of a single event, either the event recorded or the error
+func (b *eventBatcher) result(index int32, event *db.Event, err error) {
+	r := &pb.EventResult{Index: index}
+	if event != nil {
+		r.Event = event.ToProto()
+		b.resp.Recorded++
+	}
+	if err != nil {
+		s := status.Convert(err)
+		// results bypass the redaction interceptor, so mask them here
+		r.Code, r.Error = int32(s.Code()), redact.Text(s.Message())
+		b.resp.Failed++
+	}
+	b.resp.Results = append(b.resp.Results, r)
+}
@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeEventBatchStore struct {
+	batches [][]*db.Event
+	created []*db.Event
+	err     error
+	// rowErr fails single inserts of events at this timestamp
+	rowErr int64
+}
+
+func (f *fakeEventBatchStore) CreateBatch(ctx context.Context, events []*db.Event) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.batches = append(f.batches, events)
+	return nil
+}
+
+func (f *fakeEventBatchStore) Create(ctx context.Context, e *db.Event) error {
+	if e.Timestamp == f.rowErr {
+		return errors.New("data too long")
+	}
+	f.created = append(f.created, e)
+	return nil
+}
+
+func (f *fakeEventBatchStore) CreateChecked(ctx context.Context, e *db.Event, check func([]*db.Event) error) error {
+	if err := check(f.created); err != nil {
+		return err
+	}
+	return f.Create(ctx, e)
+}
+
+// fakeEventStream replays requests and collects every response sent
+type fakeEventStream struct {
+	pb.Callhandling_RecordEventsServer
+	in   []*pb.Event
+	sent []*pb.EventsResponse
+}
+
+func (f *fakeEventStream) Context() context.Context {
+	return context.Background()
+}
+
+func (f *fakeEventStream) Recv() (*pb.EventRequest, error) {
+	if len(f.in) == 0 {
+		return nil, io.EOF
+	}
+	e := f.in[0]
+	f.in = f.in[1:]
+	return &pb.EventRequest{Event: e}, nil
+}
+
+func (f *fakeEventStream) Send(resp *pb.EventsResponse) error {
+	f.sent = append(f.sent, resp)
+	return nil
+}
+
+func TestRecordEventBatch(t *testing.T) {
+	registry, err := NewEventRegistry(BuiltinEventTypes(nil, nil, nil)[:3]...)
+	if ok := assert.NoError(t, err, "Expected no error"); !ok {
+		assert.FailNow(t, "test setup failed")
+	}
+	in := &pb.EventBatchRequest{Events: []*pb.Event{
+		{CallId: 1000, EventType: DIAL, Timestamp: 100},
+		{CallId: 1000, EventType: "voicemail", Timestamp: 110},
+		{CallId: 1000, EventType: RING, Timestamp: 120},
+	}}
+
+	// ensures that valid events are written together and invalid ones reported in place
+	t.Run("Partial failure", func(t *testing.T) {
+		store := &fakeEventBatchStore{}
+		resp, err := RecordEventBatch(context.Background(), in, store, registry)
+		assert.NoError(t, err, "Expected no error")
+		assert.Len(t, store.batches, 1, "Expected a single insert")
+		assert.Len(t, store.batches[0], 2, "Expected the valid events to be written")
+
+		assert.Equal(t, int32(2), resp.GetRecorded(), "Expected two recorded events")
+		assert.Equal(t, int32(1), resp.GetFailed(), "Expected one failed event")
+		if ok := assert.Len(t, resp.GetResults(), 3, "Expected a result per event"); !ok {
+			return
+		}
+		for i, r := range resp.GetResults() {
+			assert.Equal(t, int32(i), r.GetIndex(), "Expected results in order")
+		}
+		assert.Equal(t, int32(codes.InvalidArgument), resp.GetResults()[1].GetCode(), "Expected the unknown type to be rejected")
+		assert.Equal(t, RING, resp.GetResults()[2].GetEvent().GetType(), "Expected the recorded event")
+	})
+
+	// ensures that a failed chunk falls back to single inserts, so each event gets its own result
+	t.Run("Insert failure", func(t *testing.T) {
+		store := &fakeEventBatchStore{err: errors.New("deadlock"), rowErr: 120}
+		resp, err := RecordEventBatch(context.Background(), in, store, registry)
+		assert.NoError(t, err, "Expected no error")
+		assert.Equal(t, int32(1), resp.GetRecorded(), "Expected the insertable event recorded")
+		assert.Equal(t, int32(2), resp.GetFailed(), "Expected the unknown type and the failed insert to fail")
+		assert.Len(t, store.created, 1, "Expected the events inserted one at a time")
+		assert.Equal(t, DIAL, resp.GetResults()[0].GetEvent().GetType(), "Expected the first event recorded")
+		assert.Equal(t, int32(codes.Internal), resp.GetResults()[2].GetCode(), "Expected an internal error for the failed insert")
+	})
+}
+
+func TestRecordEvents(t *testing.T) {
+	registry, err := NewEventRegistry(BuiltinEventTypes(nil, nil, nil)[:3]...)
+	if ok := assert.NoError(t, err, "Expected no error"); !ok {
+		assert.FailNow(t, "test setup failed")
+	}
+
+	// ensures that results are sent as each chunk is written rather than once the stream ends
+	stream := &fakeEventStream{}
+	for i := 0; i < eventBatchSize+1; i++ {
+		stream.in = append(stream.in, &pb.Event{CallId: 1000, EventType: DIAL, Timestamp: int64(100 + i)})
+	}
+	store := &fakeEventBatchStore{}
+	err = RecordEvents(stream, store, registry)
+	assert.NoError(t, err, "Expected no error")
+	if ok := assert.Len(t, stream.sent, 2, "Expected a response per chunk"); !ok {
+		return
+	}
+	assert.Equal(t, int32(eventBatchSize), stream.sent[0].GetRecorded(), "Expected the full chunk in the first response")
+	assert.Equal(t, int32(1), stream.sent[1].GetRecorded(), "Expected the rest in the last response")
+	assert.Equal(t, int32(eventBatchSize), stream.sent[1].GetResults()[0].GetIndex(), "Expected indexes across the whole stream")
+}
@@ -0,0 +1,308 @@
+package handlers
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	parquetsource "github.com/xitongsys/parquet-go-source/writer"
+	"github.com/xitongsys/parquet-go/parquet"
+	parquetwriter "github.com/xitongsys/parquet-go/writer"
+	"google.golang.org/grpc/codes"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/internal/phone"
+	"github.com/caring/call-handling/pb"
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+const (
+	// exportChunkSize is the number of bytes buffered before a chunk is sent
+	exportChunkSize = 64 * 1024
+	// parquetRowGroupSize bounds the rows a parquet export holds in memory before flushing
+	parquetRowGroupSize = 8 * 1024 * 1024
+)
+
+type exportMethods interface {
+	Export(context.Context, db.CallFilter, func(*db.Call, []*db.Event) error) error
+}
+
+// exportRow is a call with its metrics and events as written by every export format
+type exportRow struct {
+	CallID         int64         `json:"call_id"`
+	ConversationID int64         `json:"conversation_id"`
+	SID            int64         `json:"sid"`
+	Status         string        `json:"status"`
+	ANI            string        `json:"ANI"`
+	DNIS           string        `json:"DNIS"`
+	ANIE164        string        `json:"ANI_e164"`
+	DNISE164       string        `json:"DNIS_e164"`
+	CreatedAt      int64         `json:"created_at"`
+	Metrics        *CallMetrics  `json:"metrics"`
+	Events         []exportEvent `json:"events"`
+}
+
+type exportEvent struct {
+	Type       string `json:"type"`
+	IdentityID int64  `json:"identity_id"`
+	Timestamp  int64  `json:"timestamp"`
+	Meta       string `json:"meta"`
+}
+
+// flatRow is an exportRow flattened for tabular formats, with events encoded as JSON
+type flatRow struct {
+	CallID          int64  `parquet:"name=call_id, type=INT64"`
+	ConversationID  int64  `parquet:"name=conversation_id, type=INT64"`
+	SID             int64  `parquet:"name=sid, type=INT64"`
+	Status          string `parquet:"name=status, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	ANI             string `parquet:"name=ANI, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	DNIS            string `parquet:"name=DNIS, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	ANIE164         string `parquet:"name=ANI_e164, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	DNISE164        string `parquet:"name=DNIS_e164, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	CreatedAt       int64  `parquet:"name=created_at, type=INT64"`
+	StartedAt       int64  `parquet:"name=started_at, type=INT64"`
+	EnqueuedAt      int64  `parquet:"name=enqueued_at, type=INT64"`
+	RingingAt       int64  `parquet:"name=ringing_at, type=INT64"`
+	ConnectedAt     int64  `parquet:"name=connected_at, type=INT64"`
+	DisconnectedAt  int64  `parquet:"name=disconnected_at, type=INT64"`
+	QueueSeconds    int64  `parquet:"name=queue_seconds, type=INT64"`
+	RingSeconds     int64  `parquet:"name=ring_seconds, type=INT64"`
+	TalkSeconds     int64  `parquet:"name=talk_seconds, type=INT64"`
+	DurationSeconds int64  `parquet:"name=duration_seconds, type=INT64"`
+	Disposition     string `parquet:"name=disposition, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	EventCount      int64  `parquet:"name=event_count, type=INT64"`
+	Events          string `parquet:"name=events, type=UTF8, encoding=PLAIN_DICTIONARY"`
+}
+
+// flatColumns is the CSV header, in flatRow field order
+var flatColumns = []string{
+	"call_id", "conversation_id", "sid", "status", "ANI", "DNIS", "ANI_e164", "DNIS_e164", "created_at",
+	"started_at", "enqueued_at", "ringing_at", "connected_at", "disconnected_at",
+	"queue_seconds", "ring_seconds", "talk_seconds", "duration_seconds", "disposition", "event_count", "events",
+}
+
+// rowWriter writes export rows in a single format
+type rowWriter interface {
+	Write(*exportRow) error
+	Close() error
+}
+
+func ExportCalls(in *pb.ExportCallsRequest, stream pb.Callhandling_ExportCallsServer, store exportMethods) error {
+	ctx := stream.Context()
+
+	tenant, err := db.TenantFromCtx(ctx)
+	if err != nil {
+		return errors.WithGrpcStatus(err, codes.Unauthenticated)
+	}
+
+	if in.GetFrom() <= 0 || in.GetTo() <= in.GetFrom() {
+		return errors.WithGrpcStatus(errors.New("a time range with from before to is required"), codes.InvalidArgument)
+	}
+
+	if in.GetOutcome() != "" && !IsOutcome(in.GetOutcome()) {
+		return errors.WithGrpcStatus(errors.New("unknown outcome - "+in.GetOutcome()), codes.InvalidArgument)
+	}
+
+	filter := db.CallFilter{
+		From:    time.Unix(in.GetFrom(), 0),
+		To:      time.Unix(in.GetTo(), 0),
+		Status:  in.GetStatus(),
+		Outcome: in.GetOutcome(),
+	}
+	if in.GetDNIS() != "" {
+		if filter.DNISE164, err = phone.Normalize(in.GetDNIS(), tenant.Config.DefaultRegion); err != nil {
+			return errors.WithGrpcStatus(err, codes.InvalidArgument)
+		}
+	}
+
+	out := &chunkWriter{stream: stream}
+
+	w, err := newRowWriter(in.GetFormat(), out)
+	if err != nil {
+		return errors.WithGrpcStatus(err, codes.InvalidArgument)
+	}
+
+	err = store.Export(ctx, filter, func(call *db.Call, events []*db.Event) error {
+		return w.Write(newExportRow(call, events))
+	})
+	if err != nil {
+		return errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	if err = w.Close(); err != nil {
+		return errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	if err = out.Flush(); err != nil {
+		return errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	return nil
+}
+
+// newExportRow assembles a call's export row and computes its metrics
+func newExportRow(call *db.Call, events []*db.Event) *exportRow {
+	r := &exportRow{
+		CallID:         call.ID,
+		ConversationID: call.ConversationID,
+		SID:            call.SID,
+		Status:         call.Status,
+		ANI:            call.ANI,
+		DNIS:           call.DNIS,
+		ANIE164:        call.ANIE164,
+		DNISE164:       call.DNISE164,
+		CreatedAt:      call.CreatedAt.Unix(),
+		Metrics:        ComputeMetrics(events),
+		Events:         make([]exportEvent, 0, len(events)),
+	}
+	for _, e := range events {
+		r.Events = append(r.Events, exportEvent{
+			Type:       e.Type,
+			IdentityID: e.IdentityID,
+			Timestamp:  e.Timestamp,
+			Meta:       e.Meta,
+		})
+	}
+	return r
+}
+
+// flatten converts an export row for tabular formats
+func (r *exportRow) flatten() (*flatRow, error) {
+	events, err := json.Marshal(r.Events)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	m := r.Metrics
+	return &flatRow{
+		CallID:          r.CallID,
+		ConversationID:  r.ConversationID,
+		SID:             r.SID,
+		Status:          r.Status,
+		ANI:             r.ANI,
+		DNIS:            r.DNIS,
+		ANIE164:         r.ANIE164,
+		DNISE164:        r.DNISE164,
+		CreatedAt:       r.CreatedAt,
+		StartedAt:       m.StartedAt,
+		EnqueuedAt:      m.EnqueuedAt,
+		RingingAt:       m.RingingAt,
+		ConnectedAt:     m.ConnectedAt,
+		DisconnectedAt:  m.DisconnectedAt,
+		QueueSeconds:    m.QueueSeconds,
+		RingSeconds:     m.RingSeconds,
+		TalkSeconds:     m.TalkSeconds,
+		DurationSeconds: m.DurationSeconds,
+		Disposition:     m.Disposition,
+		EventCount:      int64(m.EventCount),
+		Events:          string(events),
+	}, nil
+}
+
+// newRowWriter creates the writer for an export format
+func newRowWriter(format pb.ExportFormat, w io.Writer) (rowWriter, error) {
+	switch format {
+	case pb.ExportFormat_CSV:
+		c := csv.NewWriter(w)
+		if err := c.Write(flatColumns); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return &csvWriter{c}, nil
+	case pb.ExportFormat_NDJSON:
+		return &ndjsonWriter{json.NewEncoder(w)}, nil
+	case pb.ExportFormat_PARQUET:
+		pw, err := parquetwriter.NewParquetWriter(parquetsource.NewWriterFile(w), new(flatRow), 1)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		pw.RowGroupSize = parquetRowGroupSize
+		pw.CompressionType = parquet.CompressionCodec_SNAPPY
+		return &parquetWriter{pw}, nil
+	}
+	return nil, errors.New("unknown export format - " + format.String())
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvWriter) Write(r *exportRow) error {
+	f, err := r.flatten()
+	if err != nil {
+		return err
+	}
+	i := func(v int64) string { return strconv.FormatInt(v, 10) }
+	return c.w.Write([]string{
+		i(f.CallID), i(f.ConversationID), i(f.SID), f.Status, f.ANI, f.DNIS, f.ANIE164, f.DNISE164, i(f.CreatedAt),
+		i(f.StartedAt), i(f.EnqueuedAt), i(f.RingingAt), i(f.ConnectedAt), i(f.DisconnectedAt),
+		i(f.QueueSeconds), i(f.RingSeconds), i(f.TalkSeconds), i(f.DurationSeconds), f.Disposition, i(f.EventCount), f.Events,
+	})
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+type ndjsonWriter struct {
+	enc *json.Encoder
+}
+
+func (n *ndjsonWriter) Write(r *exportRow) error {
+	return errors.WithStack(n.enc.Encode(r))
+}
+
+func (n *ndjsonWriter) Close() error {
+	return nil
+}
+
+type parquetWriter struct {
+	w *parquetwriter.ParquetWriter
+}
+
+func (p *parquetWriter) Write(r *exportRow) error {
+	f, err := r.flatten()
+	if err != nil {
+		return err
+	}
+	return errors.WithStack(p.w.Write(f))
+}
+
+func (p *parquetWriter) Close() error {
+	return errors.WithStack(p.w.WriteStop())
+}
+
+// chunkWriter buffers export bytes and sends them on the stream in fixed size chunks
+type chunkWriter struct {
+	stream pb.Callhandling_ExportCallsServer
+	buf    []byte
+}
+
+func (c *chunkWriter) Write(p []byte) (int, error) {
+	c.buf = append(c.buf, p...)
+	for len(c.buf) >= exportChunkSize {
+		if err := c.send(c.buf[:exportChunkSize]); err != nil {
+			return 0, err
+		}
+		c.buf = c.buf[exportChunkSize:]
+	}
+	return len(p), nil
+}
+
+// Flush sends any buffered bytes
+func (c *chunkWriter) Flush() error {
+	if len(c.buf) == 0 {
+		return nil
+	}
+	err := c.send(c.buf)
+	c.buf = nil
+	return err
+}
+
+func (c *chunkWriter) send(data []byte) error {
+	chunk := make([]byte, len(data))
+	copy(chunk, data)
+	return c.stream.Send(&pb.ExportChunk{Data: chunk})
+}
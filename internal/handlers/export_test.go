@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeExportStore struct {
+	calls  []*db.Call
+	filter db.CallFilter
+}
+
+func (f *fakeExportStore) Export(ctx context.Context, filter db.CallFilter, fn func(*db.Call, []*db.Event) error) error {
+	f.filter = filter
+	for _, c := range f.calls {
+		events := []*db.Event{
+			{CallID: c.ID, Type: CONNECT, Timestamp: 100},
+			{CallID: c.ID, Type: DISCONNECT, Timestamp: 160},
+		}
+		if err := fn(c, events); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type fakeExportStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	chunks [][]byte
+}
+
+func (f *fakeExportStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeExportStream) Send(c *pb.ExportChunk) error {
+	f.chunks = append(f.chunks, c.GetData())
+	return nil
+}
+
+func (f *fakeExportStream) bytes() []byte {
+	return bytes.Join(f.chunks, nil)
+}
+
+func TestExportCalls(t *testing.T) {
+	ctx := db.TenantToCtx(context.Background(), &db.Tenant{ID: "tenant-a"})
+	store := &fakeExportStore{calls: []*db.Call{
+		{ID: 1, Status: "completed", DNIS: "8005550100", CreatedAt: time.Unix(100, 0)},
+		{ID: 2, Status: "completed", DNIS: "8005550100", CreatedAt: time.Unix(200, 0)},
+	}}
+	in := &pb.ExportCallsRequest{From: 1, To: 1000, DNIS: "(800) 555-0100"}
+
+	// ensures that CSV exports have a header and a row per call with metrics
+	t.Run("CSV", func(t *testing.T) {
+		stream := &fakeExportStream{ctx: ctx}
+		in.Format = pb.ExportFormat_CSV
+
+		err := ExportCalls(in, stream, store)
+		assert.NoError(t, err, "Expected no error")
+		assert.Equal(t, "+18005550100", store.filter.DNISE164, "Expected the DNIS filter to be normalized")
+
+		records, err := csv.NewReader(bytes.NewReader(stream.bytes())).ReadAll()
+		assert.NoError(t, err, "Expected valid CSV")
+		assert.Len(t, records, 3, "Expected a header and two calls")
+		assert.Equal(t, flatColumns, records[0], "Expected the header")
+		assert.Equal(t, "60", records[1][16], "Expected the talk time")
+	})
+
+	// ensures that NDJSON exports have a line per call
+	t.Run("NDJSON", func(t *testing.T) {
+		stream := &fakeExportStream{ctx: ctx}
+		in.Format = pb.ExportFormat_NDJSON
+
+		err := ExportCalls(in, stream, store)
+		assert.NoError(t, err, "Expected no error")
+
+		lines := strings.Split(strings.TrimSpace(string(stream.bytes())), "\n")
+		assert.Len(t, lines, 2, "Expected two calls")
+		assert.Contains(t, lines[0], `"talk_seconds":60`, "Expected nested metrics")
+	})
+
+	// ensures that Parquet exports produce a complete file
+	t.Run("Parquet", func(t *testing.T) {
+		stream := &fakeExportStream{ctx: ctx}
+		in.Format = pb.ExportFormat_PARQUET
+
+		err := ExportCalls(in, stream, store)
+		assert.NoError(t, err, "Expected no error")
+
+		out := stream.bytes()
+		assert.True(t, bytes.HasPrefix(out, []byte("PAR1")), "Expected the parquet header magic")
+		assert.True(t, bytes.HasSuffix(out, []byte("PAR1")), "Expected the parquet footer magic")
+	})
+
+	// ensures that a time range is required
+	t.Run("Missing range", func(t *testing.T) {
+		err := ExportCalls(&pb.ExportCallsRequest{}, &fakeExportStream{ctx: ctx}, store)
+		assert.Error(t, err, "Expected an invalid argument error")
+	})
+}
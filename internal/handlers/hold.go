@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/pb"
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+// names of the hold event types, see HoldEventTypes
+const (
+	HOLD   = "held"
+	RESUME = "resumed"
+)
+
+// defaultHoldReportLimit is the number of longest holds listed per agent when none is requested
+const defaultHoldReportLimit = 5
+
+type eventLister interface {
+	ListByCall(context.Context, int64) ([]*db.Event, error)
+}
+
+type holdReportMethods interface {
+	ListCallsWithEvent(context.Context, string, int64, int64) ([]*db.Event, error)
+}
+
+// Hold is a single period a call spent on hold
+type Hold struct {
+	CallID int64
+	// IdentityID is the agent who placed the call on hold
+	IdentityID int64
+	StartedAt  int64
+	// EndedAt is the resume, or the disconnect or last event of a call left on hold
+	EndedAt int64
+	Seconds int64
+}
+
+// HoldEventTypes are the event types of placing a call on hold and resuming it.
+// Holds must alternate with resumes, so a call cannot be held twice or resumed unheld.
+func HoldEventTypes() []*EventType {
+	return []*EventType{
+		{Name: HOLD, RequireIdentity: true, Precondition: balancedHolds(false)},
+		{Name: RESUME, RequireIdentity: true, Precondition: balancedHolds(true)},
+	}
+}
+
+// balancedHolds is a precondition that the call's hold state is held at the event's timestamp
+func balancedHolds(held bool) func(context.Context, *db.Event, []*db.Event) error {
+	return func(ctx context.Context, e *db.Event, recorded []*db.Event) error {
+		if onHold(recorded) != held {
+			if held {
+				return errors.Wrap(ErrPrecondition, "call is not on hold")
+			}
+			return errors.Wrap(ErrPrecondition, "call is already on hold")
+		}
+		return nil
+	}
+}
+
+// onHold reports whether a call's events, sorted by timestamp, leave it on hold
+func onHold(events []*db.Event) bool {
+	held := false
+	for _, e := range events {
+		switch e.Type {
+		case HOLD:
+			held = true
+		case RESUME, DISCONNECT:
+			held = false
+		}
+	}
+	return held
+}
+
+// Holds pairs the holds and resumes of calls into hold periods. events must be ordered
+// by timestamp within each call, and may hold the events of several calls.
+func Holds(events []*db.Event) []Hold {
+	var (
+		holds = []Hold{}
+		open  = map[int64]*Hold{}
+		last  = map[int64]int64{}
+	)
+	end := func(callID, ts int64) {
+		if h, ok := open[callID]; ok {
+			h.EndedAt, h.Seconds = ts, span(h.StartedAt, ts)
+			holds = append(holds, *h)
+			delete(open, callID)
+		}
+	}
+
+	for _, e := range events {
+		last[e.CallID] = e.Timestamp
+		switch e.Type {
+		case HOLD:
+			if _, ok := open[e.CallID]; !ok {
+				open[e.CallID] = &Hold{CallID: e.CallID, IdentityID: e.IdentityID, StartedAt: e.Timestamp}
+			}
+		case RESUME, DISCONNECT:
+			end(e.CallID, e.Timestamp)
+		}
+	}
+
+	// calls still on hold are measured to their last event
+	callIDs := make([]int64, 0, len(open))
+	for callID := range open {
+		callIDs = append(callIDs, callID)
+	}
+	sort.Slice(callIDs, func(i, j int) bool { return callIDs[i] < callIDs[j] })
+	for _, callID := range callIDs {
+		end(callID, last[callID])
+	}
+
+	return holds
+}
+
+func GetHoldReport(ctx context.Context, in *pb.HoldReportRequest, store holdReportMethods) (*pb.HoldReport, error) {
+	if in.GetFrom() <= 0 || in.GetTo() <= in.GetFrom() {
+		return nil, errors.WithGrpcStatus(errors.New("a time range with from before to is required"), codes.InvalidArgument)
+	}
+
+	limit := int(in.GetLimit())
+	if limit <= 0 {
+		limit = defaultHoldReportLimit
+	}
+
+	events, err := store.ListCallsWithEvent(ctx, HOLD, in.GetFrom(), in.GetTo())
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	agents := map[int64]*pb.AgentHolds{}
+	for _, h := range Holds(events) {
+		if h.StartedAt < in.GetFrom() || h.StartedAt >= in.GetTo() {
+			continue
+		}
+		if in.GetIdentityId() != 0 && h.IdentityID != in.GetIdentityId() {
+			continue
+		}
+
+		a, ok := agents[h.IdentityID]
+		if !ok {
+			a = &pb.AgentHolds{IdentityId: h.IdentityID}
+			agents[h.IdentityID] = a
+		}
+		a.HoldCount++
+		a.TotalHoldSeconds += h.Seconds
+		a.Longest = append(a.Longest, &pb.Hold{
+			CallId:     h.CallID,
+			IdentityId: h.IdentityID,
+			StartedAt:  h.StartedAt,
+			EndedAt:    h.EndedAt,
+			Seconds:    h.Seconds,
+		})
+	}
+
+	report := &pb.HoldReport{Agents: make([]*pb.AgentHolds, 0, len(agents))}
+	for _, a := range agents {
+		sort.SliceStable(a.Longest, func(i, j int) bool { return a.Longest[i].Seconds > a.Longest[j].Seconds })
+		if len(a.Longest) > limit {
+			a.Longest = a.Longest[:limit]
+		}
+		report.Agents = append(report.Agents, a)
+	}
+	sort.Slice(report.Agents, func(i, j int) bool {
+		a, b := report.Agents[i], report.Agents[j]
+		if a.Longest[0].Seconds != b.Longest[0].Seconds {
+			return a.Longest[0].Seconds > b.Longest[0].Seconds
+		}
+		return a.IdentityId < b.IdentityId
+	})
+
+	return report, nil
+}
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeHoldEvents struct {
+	events []*db.Event
+}
+
+func (f *fakeHoldEvents) ListByCall(ctx context.Context, callID int64) ([]*db.Event, error) {
+	return f.events, nil
+}
+
+func (f *fakeHoldEvents) ListCallsWithEvent(ctx context.Context, eventType string, from, to int64) ([]*db.Event, error) {
+	return f.events, nil
+}
+
+func (f *fakeHoldEvents) Create(ctx context.Context, e *db.Event) error {
+	f.events = append(f.events, e)
+	return nil
+}
+
+func (f *fakeHoldEvents) CreateChecked(ctx context.Context, e *db.Event, check func([]*db.Event) error) error {
+	recorded := []*db.Event{}
+	for _, r := range f.events {
+		if r.Timestamp <= e.Timestamp {
+			recorded = append(recorded, r)
+		}
+	}
+	if err := check(recorded); err != nil {
+		return err
+	}
+	return f.Create(ctx, e)
+}
+
+func TestRecordEvent_holds(t *testing.T) {
+	store := &fakeHoldEvents{}
+	registry, err := NewEventRegistry(HoldEventTypes()...)
+	if ok := assert.NoError(t, err, "Expected no error"); !ok {
+		assert.FailNow(t, "test setup failed")
+	}
+	record := func(eventType string, ts int64) error {
+		_, err := RecordEvent(context.Background(), &pb.EventRequest{
+			Event: &pb.Event{CallId: 1000, IdentityId: 7, Timestamp: ts, EventType: eventType},
+		}, store, registry)
+		return err
+	}
+
+	// ensures that holds must alternate with resumes
+	assert.Equal(t, codes.FailedPrecondition, status.Code(record(RESUME, 100)), "Expected a resume without a hold to fail")
+	assert.NoError(t, record(HOLD, 110), "Expected the hold to be recorded")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(record(HOLD, 120)), "Expected a second hold to fail")
+	assert.NoError(t, record(RESUME, 130), "Expected the resume to be recorded")
+	assert.Len(t, store.events, 2, "Expected only the balanced events to be stored")
+
+	// ensures that the hold state is taken at the event's timestamp rather than the latest
+	assert.Equal(t, codes.FailedPrecondition, status.Code(record(RESUME, 105)), "Expected a resume before the hold to fail")
+	assert.NoError(t, record(HOLD, 140), "Expected a hold after the resume to be recorded")
+}
+
+func TestComputeMetrics_holds(t *testing.T) {
+	m := ComputeMetrics([]*db.Event{
+		{Type: CONNECT, IdentityID: 7, Timestamp: 100},
+		{Type: HOLD, IdentityID: 7, Timestamp: 110},
+		{Type: RESUME, IdentityID: 7, Timestamp: 140},
+		{Type: HOLD, IdentityID: 7, Timestamp: 150},
+		{Type: DISCONNECT, Timestamp: 160},
+	})
+
+	// ensures that a hold left open at disconnect ends with the call
+	assert.Equal(t, 2, m.HoldCount, "Expected two holds")
+	assert.Equal(t, int64(40), m.HoldSeconds, "Expected the total hold time")
+}
+
+func TestGetHoldReport(t *testing.T) {
+	store := &fakeHoldEvents{events: []*db.Event{
+		{CallID: 1, Type: HOLD, IdentityID: 7, Timestamp: 100},
+		{CallID: 1, Type: RESUME, IdentityID: 7, Timestamp: 130},
+		{CallID: 1, Type: HOLD, IdentityID: 7, Timestamp: 200},
+		{CallID: 1, Type: RESUME, IdentityID: 7, Timestamp: 290},
+		{CallID: 2, Type: HOLD, IdentityID: 8, Timestamp: 100},
+		{CallID: 2, Type: RESUME, IdentityID: 8, Timestamp: 160},
+	}}
+
+	// ensures that agents are ranked by their longest hold and list holds longest first
+	report, err := GetHoldReport(context.Background(), &pb.HoldReportRequest{From: 1, To: 1000, Limit: 1}, store)
+	assert.NoError(t, err, "Expected no error")
+	if ok := assert.Len(t, report.GetAgents(), 2, "Expected both agents"); !ok {
+		return
+	}
+
+	first := report.GetAgents()[0]
+	assert.Equal(t, int64(7), first.GetIdentityId(), "Expected the agent with the longest hold first")
+	assert.Equal(t, int32(2), first.GetHoldCount(), "Expected every hold counted")
+	assert.Equal(t, int64(120), first.GetTotalHoldSeconds(), "Expected the total hold time")
+	assert.Len(t, first.GetLongest(), 1, "Expected the limit to apply")
+	assert.Equal(t, int64(90), first.GetLongest()[0].GetSeconds(), "Expected the longest hold")
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+// defaultBusinessDays are the days open when business hours do not list any
+var defaultBusinessDays = []string{"Mon", "Tue", "Wed", "Thu", "Fri"}
+
+// tenantLocation is the tenant's timezone, UTC when unset or unknown
+func tenantLocation(cfg db.TenantConfig) *time.Location {
+	if cfg.Timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// NextOpen is the earliest time at or after t within the tenant's business hours. Tenants
+// without business hours are always open.
+func NextOpen(cfg db.TenantConfig, t time.Time) (time.Time, error) {
+	h := cfg.BusinessHours
+	if h == nil {
+		return t, nil
+	}
+
+	opens, err := time.Parse("15:04", h.Open)
+	if err != nil {
+		return t, errors.Wrap(err, "business hours open")
+	}
+	closes, err := time.Parse("15:04", h.Close)
+	if err != nil {
+		return t, errors.Wrap(err, "business hours close")
+	}
+	if !closes.After(opens) {
+		return t, errors.New("business hours must close after they open")
+	}
+
+	names := h.Days
+	if len(names) == 0 {
+		names = defaultBusinessDays
+	}
+	days := map[string]bool{}
+	for _, d := range names {
+		days[d] = true
+	}
+
+	local := t.In(tenantLocation(cfg))
+	for i := 0; i < 8; i++ {
+		day := local.AddDate(0, 0, i)
+		if !days[day.Weekday().String()[:3]] {
+			continue
+		}
+
+		y, m, d := day.Date()
+		opensAt := time.Date(y, m, d, opens.Hour(), opens.Minute(), 0, 0, local.Location())
+		closesAt := time.Date(y, m, d, closes.Hour(), closes.Minute(), 0, 0, local.Location())
+		switch {
+		case local.Before(opensAt):
+			return opensAt, nil
+		case local.Before(closesAt):
+			return local, nil
+		}
+	}
+
+	return t, errors.New("business hours have no open days")
+}
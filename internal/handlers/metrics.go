@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"sort"
+
+	"github.com/caring/call-handling/internal/db"
+)
+
+// CallMetrics are the phase timestamps and durations of a single call, computed from its
+// events. Timestamps are unix seconds and are 0 when the phase was not observed.
+type CallMetrics struct {
+	StartedAt      int64 `json:"started_at"`
+	EnqueuedAt     int64 `json:"enqueued_at"`
+	RingingAt      int64 `json:"ringing_at"`
+	ConnectedAt    int64 `json:"connected_at"`
+	DisconnectedAt int64 `json:"disconnected_at"`
+
+	// QueueSeconds is the time from enqueue to connect, or to disconnect if never connected
+	QueueSeconds int64 `json:"queue_seconds"`
+	// RingSeconds is the time from first ring to connect, or to disconnect if never connected
+	RingSeconds int64 `json:"ring_seconds"`
+	// TalkSeconds is the time from connect to disconnect
+	TalkSeconds int64 `json:"talk_seconds"`
+	// DurationSeconds is the time from the first event to disconnect, or to the last event
+	DurationSeconds int64 `json:"duration_seconds"`
+
+	// Disposition is the meta of the last dispositioned event
+	Disposition string `json:"disposition"`
+	// EventCount is the number of events the metrics were computed from
+	EventCount int `json:"event_count"`
+
+	// AgentTalkSeconds is the time each identity spent on the call, keyed by identity id.
+	// Transfers hand talk time from the source agent to the target, and during a warm
+	// transfer's consult both agents accrue it.
+	AgentTalkSeconds map[int64]int64 `json:"agent_talk_seconds"`
+	// Transfers is the number of completed transfers
+	Transfers int `json:"transfers"`
+
+	// HoldCount is the number of times the call was placed on hold
+	HoldCount int `json:"hold_count"`
+	// HoldSeconds is the total time the call spent on hold
+	HoldSeconds int64 `json:"hold_seconds"`
+}
+
+// ComputeMetrics derives a call's metrics from its events, which need not be sorted
+func ComputeMetrics(events []*db.Event) *CallMetrics {
+	m := &CallMetrics{EventCount: len(events), AgentTalkSeconds: map[int64]int64{}}
+	if len(events) == 0 {
+		return m
+	}
+
+	sorted := make([]*db.Event, len(events))
+	copy(sorted, events)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Timestamp < sorted[j].Timestamp })
+
+	m.StartedAt = sorted[0].Timestamp
+	last := sorted[len(sorted)-1].Timestamp
+
+	for _, e := range sorted {
+		switch e.Type {
+		case ENQUEUE:
+			setFirst(&m.EnqueuedAt, e.Timestamp)
+		case RING:
+			setFirst(&m.RingingAt, e.Timestamp)
+		case CONNECT:
+			setFirst(&m.ConnectedAt, e.Timestamp)
+		case DISCONNECT:
+			m.DisconnectedAt = e.Timestamp
+		case DISPO:
+			m.Disposition = e.Meta
+		}
+	}
+
+	// the end of waiting is the connect, or the hang up for calls that never connected
+	waitEnd := m.ConnectedAt
+	if waitEnd == 0 {
+		waitEnd = m.DisconnectedAt
+	}
+	m.QueueSeconds = span(m.EnqueuedAt, waitEnd)
+	m.RingSeconds = span(m.RingingAt, waitEnd)
+	m.TalkSeconds = span(m.ConnectedAt, m.DisconnectedAt)
+
+	end := m.DisconnectedAt
+	if end == 0 {
+		end = last
+	}
+	m.DurationSeconds = span(m.StartedAt, end)
+
+	m.Transfers = attributeTalk(sorted, m.AgentTalkSeconds)
+
+	for _, h := range Holds(sorted) {
+		m.HoldCount++
+		m.HoldSeconds += h.Seconds
+	}
+
+	return m
+}
+
+// attributeTalk adds the time each identity was on the call to talk, following parties as
+// they connect, join, exit and are transferred, and returns the number of completed transfers.
+// events must be sorted by timestamp.
+func attributeTalk(events []*db.Event, talk map[int64]int64) (transfers int) {
+	open := map[int64]int64{}
+	start := func(identity, ts int64) {
+		if _, ok := open[identity]; !ok && identity != 0 {
+			open[identity] = ts
+		}
+	}
+	stop := func(identity, ts int64) {
+		if from, ok := open[identity]; ok {
+			talk[identity] += span(from, ts)
+			delete(open, identity)
+		}
+	}
+
+	for _, e := range events {
+		switch e.Type {
+		case CONNECT, JOIN:
+			start(e.IdentityID, e.Timestamp)
+		case EXIT:
+			stop(e.IdentityID, e.Timestamp)
+		case TRANSFER_CONSULTED, TRANSFER_COMPLETED, TRANSFER_FAILED:
+			t, err := ParseTransferMeta(e)
+			if err != nil {
+				continue
+			}
+			switch e.Type {
+			case TRANSFER_CONSULTED:
+				start(t.ToIdentity, e.Timestamp)
+			case TRANSFER_COMPLETED:
+				stop(t.FromIdentity, e.Timestamp)
+				start(t.ToIdentity, e.Timestamp)
+				transfers++
+			case TRANSFER_FAILED:
+				stop(t.ToIdentity, e.Timestamp)
+			}
+		case DISCONNECT:
+			for identity := range open {
+				stop(identity, e.Timestamp)
+			}
+		}
+	}
+
+	return transfers
+}
+
+// splitCalls splits events grouped by call into the events of each call
+func splitCalls(events []*db.Event) [][]*db.Event {
+	calls := [][]*db.Event{}
+	start := 0
+	for i, e := range events {
+		if e.CallID != events[start].CallID {
+			calls = append(calls, events[start:i])
+			start = i
+		}
+	}
+	if len(events) > 0 {
+		calls = append(calls, events[start:])
+	}
+	return calls
+}
+
+// setFirst sets dst to ts unless it was already set
+func setFirst(dst *int64, ts int64) {
+	if *dst == 0 {
+		*dst = ts
+	}
+}
+
+// span is the non negative time between two observed timestamps, 0 if either is missing
+func span(from, to int64) int64 {
+	if from == 0 || to == 0 || to < from {
+		return 0
+	}
+	return to - from
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeMetrics(t *testing.T) {
+	// ensures that an answered call's phases are measured from its events
+	t.Run("Answered call", func(t *testing.T) {
+		m := ComputeMetrics([]*db.Event{
+			{Type: DISCONNECT, Timestamp: 1300},
+			{Type: DIAL, Timestamp: 1000},
+			{Type: ENQUEUE, Timestamp: 1005},
+			{Type: RING, Timestamp: 1030},
+			{Type: CONNECT, Timestamp: 1040},
+			{Type: DISPO, Timestamp: 1320, Meta: "sale"},
+		})
+
+		assert.Equal(t, int64(1000), m.StartedAt, "Expected the first event to start the call")
+		assert.Equal(t, int64(35), m.QueueSeconds, "Expected queue time from enqueue to connect")
+		assert.Equal(t, int64(10), m.RingSeconds, "Expected ring time from ring to connect")
+		assert.Equal(t, int64(260), m.TalkSeconds, "Expected talk time from connect to disconnect")
+		assert.Equal(t, int64(300), m.DurationSeconds, "Expected duration from start to disconnect")
+		assert.Equal(t, "sale", m.Disposition, "Expected the disposition meta")
+		assert.Equal(t, 6, m.EventCount, "Expected every event to be counted")
+	})
+
+	// ensures that a call abandoned in queue has no talk time
+	t.Run("Abandoned call", func(t *testing.T) {
+		m := ComputeMetrics([]*db.Event{
+			{Type: ENQUEUE, Timestamp: 2000},
+			{Type: DISCONNECT, Timestamp: 2090},
+		})
+
+		assert.Equal(t, int64(90), m.QueueSeconds, "Expected queue time until the hang up")
+		assert.Equal(t, int64(0), m.TalkSeconds, "Expected no talk time")
+		assert.Equal(t, int64(0), m.ConnectedAt, "Expected no connect")
+	})
+
+	// ensures that a call without events has empty metrics
+	t.Run("No events", func(t *testing.T) {
+		assert.Equal(t, &CallMetrics{AgentTalkSeconds: map[int64]int64{}}, ComputeMetrics(nil), "Expected empty metrics")
+	})
+}
@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+// VOICEMAIL is the event type of a caller being sent to voicemail, see OutcomeEventTypes
+const VOICEMAIL = "voicemail"
+
+// call outcomes, see ClassifyOutcome
+const (
+	OutcomeAnswered         = "answered"
+	OutcomeAbandonedInQueue = "abandoned_in_queue"
+	OutcomeAbandonedRinging = "abandoned_ringing"
+	OutcomeShortAbandon     = "short_abandon"
+	OutcomeMissed           = "missed"
+	OutcomeVoicemail        = "voicemail"
+)
+
+// defaultShortAbandonSeconds is the short abandon threshold of tenants that do not set one
+const defaultShortAbandonSeconds = 10
+
+var outcomes = map[string]bool{
+	OutcomeAnswered:         true,
+	OutcomeAbandonedInQueue: true,
+	OutcomeAbandonedRinging: true,
+	OutcomeShortAbandon:     true,
+	OutcomeMissed:           true,
+	OutcomeVoicemail:        true,
+}
+
+// IsOutcome reports whether outcome is one ClassifyOutcome can return
+func IsOutcome(outcome string) bool {
+	return outcomes[outcome]
+}
+
+type outcomeSetter interface {
+	SetOutcome(context.Context, int64, string) error
+}
+
+// callClassifier records the outcome of a call once it has ended
+type callClassifier interface {
+	Classify(context.Context, int64) (string, error)
+}
+
+// Classifier classifies and stores the outcome of calls that have disconnected
+type Classifier struct {
+	events eventLister
+	calls  outcomeSetter
+}
+
+// NewClassifier creates a Classifier that stores outcomes on calls
+func NewClassifier(events eventLister, calls outcomeSetter) *Classifier {
+	return &Classifier{events, calls}
+}
+
+// Classify stores the outcome of a call if it has disconnected, using the short abandon
+// threshold of the tenant in ctx. It returns an empty outcome while the call is in progress.
+func (c *Classifier) Classify(ctx context.Context, callID int64) (string, error) {
+	tenant, err := db.TenantFromCtx(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	events, err := c.events.ListByCall(ctx, callID)
+	if err != nil {
+		return "", err
+	}
+	if !hasEvent(events, DISCONNECT) {
+		return "", nil
+	}
+
+	outcome := ClassifyOutcome(events, shortAbandonSeconds(tenant.Config))
+	err = c.calls.SetOutcome(ctx, callID, outcome)
+	if errors.Is(err, db.ErrNoRowsAffected) {
+		// an unchanged row is not affected, which happens when a call is reclassified the same way
+		err = nil
+	}
+	return outcome, err
+}
+
+// shortAbandonSeconds is the tenant's short abandon threshold, 0 when disabled
+func shortAbandonSeconds(cfg db.TenantConfig) int64 {
+	switch {
+	case cfg.ShortAbandonSeconds < 0:
+		return 0
+	case cfg.ShortAbandonSeconds == 0:
+		return defaultShortAbandonSeconds
+	}
+	return int64(cfg.ShortAbandonSeconds)
+}
+
+// ClassifyOutcome derives how a call ended from its events, which need not be sorted:
+//   - voicemail when the caller was sent to voicemail
+//   - answered when the call connected
+//   - short_abandon when the caller hung up after waiting in queue or ringing for
+//     less than shortAbandonSeconds
+//   - abandoned_ringing when the caller hung up while an agent was being rung
+//   - abandoned_in_queue when the caller hung up while queued
+//   - missed when the call ended without being queued or rung
+func ClassifyOutcome(events []*db.Event, shortAbandonSeconds int64) string {
+	if hasEvent(events, VOICEMAIL) {
+		return OutcomeVoicemail
+	}
+
+	m := ComputeMetrics(events)
+	if m.ConnectedAt != 0 {
+		return OutcomeAnswered
+	}
+
+	// the queue covers any ringing that follows it, so it is the wait when the call was queued
+	wait, waited := m.RingSeconds, m.RingingAt != 0
+	if m.EnqueuedAt != 0 {
+		wait, waited = m.QueueSeconds, true
+	}
+
+	switch {
+	case waited && wait < shortAbandonSeconds:
+		return OutcomeShortAbandon
+	case m.RingingAt != 0:
+		return OutcomeAbandonedRinging
+	case m.EnqueuedAt != 0:
+		return OutcomeAbandonedInQueue
+	}
+	return OutcomeMissed
+}
+
+// hasEvent reports whether any of events is of type t
+func hasEvent(events []*db.Event, t string) bool {
+	for _, e := range events {
+		if e.Type == t {
+			return true
+		}
+	}
+	return false
+}
+
+// ClassifiesOutcome is an effect that stores the outcome of the call once it has ended
+func ClassifiesOutcome(classifier callClassifier) EventEffect {
+	return func(ctx context.Context, e *db.Event) error {
+		_, err := classifier.Classify(ctx, e.CallID)
+		return err
+	}
+}
+
+// OutcomeEventTypes are the event types that only affect a call's outcome. A voicemail
+// recorded after the call disconnected reclassifies it.
+func OutcomeEventTypes(classifier callClassifier) []*EventType {
+	return []*EventType{
+		{Name: VOICEMAIL, Effects: []EventEffect{ClassifiesOutcome(classifier)}},
+	}
+}
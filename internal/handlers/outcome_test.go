@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeOutcomes struct {
+	fakeHoldEvents
+	outcome string
+}
+
+func (f *fakeOutcomes) SetOutcome(ctx context.Context, callID int64, outcome string) error {
+	f.outcome = outcome
+	return nil
+}
+
+func TestClassifyOutcome(t *testing.T) {
+	tests := []struct {
+		name   string
+		events []*db.Event
+		want   string
+	}{
+		{"Answered", []*db.Event{{Type: ENQUEUE, Timestamp: 100}, {Type: RING, Timestamp: 110}, {Type: CONNECT, Timestamp: 120}, {Type: DISCONNECT, Timestamp: 200}}, OutcomeAnswered},
+		{"Voicemail", []*db.Event{{Type: ENQUEUE, Timestamp: 100}, {Type: VOICEMAIL, Timestamp: 160}, {Type: DISCONNECT, Timestamp: 200}}, OutcomeVoicemail},
+		{"Abandoned in queue", []*db.Event{{Type: ENQUEUE, Timestamp: 100}, {Type: DISCONNECT, Timestamp: 160}}, OutcomeAbandonedInQueue},
+		{"Abandoned ringing", []*db.Event{{Type: ENQUEUE, Timestamp: 100}, {Type: RING, Timestamp: 150}, {Type: DISCONNECT, Timestamp: 160}}, OutcomeAbandonedRinging},
+		{"Short abandon", []*db.Event{{Type: ENQUEUE, Timestamp: 100}, {Type: RING, Timestamp: 102}, {Type: DISCONNECT, Timestamp: 105}}, OutcomeShortAbandon},
+		{"Missed", []*db.Event{{Type: DIAL, Timestamp: 100}, {Type: DISCONNECT, Timestamp: 102}}, OutcomeMissed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ClassifyOutcome(tt.events, defaultShortAbandonSeconds), "Expected outcomes to match")
+		})
+	}
+}
+
+func TestClassifier_classify(t *testing.T) {
+	store := &fakeOutcomes{}
+	store.events = []*db.Event{{Type: ENQUEUE, Timestamp: 100}, {Type: DISCONNECT, Timestamp: 120}}
+	classifier := NewClassifier(store, store)
+
+	// ensures that the tenant's threshold decides short abandons
+	ctx := db.TenantToCtx(context.Background(), &db.Tenant{ID: "tenant-a", Config: db.TenantConfig{ShortAbandonSeconds: 30}})
+	outcome, err := classifier.Classify(ctx, 1000)
+	assert.NoError(t, err, "Expected no error")
+	assert.Equal(t, OutcomeShortAbandon, outcome, "Expected a short abandon under the tenant threshold")
+	assert.Equal(t, OutcomeShortAbandon, store.outcome, "Expected the outcome to be stored")
+
+	// ensures that calls still in progress are not classified
+	store.events, store.outcome = store.events[:1], ""
+	outcome, err = classifier.Classify(ctx, 1000)
+	assert.NoError(t, err, "Expected no error")
+	assert.Empty(t, outcome, "Expected no outcome before disconnect")
+	assert.Empty(t, store.outcome, "Expected nothing to be stored")
+}
@@ -0,0 +1,211 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+// EventType declares how events of one type are validated and what recording them triggers.
+// Registering an EventType is all it takes for RecordEvent to accept it.
+type EventType struct {
+	// Name is the value clients send as the event type and the type stored on the event
+	Name string
+	// RequireIdentity rejects events that do not name the identity they concern
+	RequireIdentity bool
+	// Meta is the shape of the event's meta
+	Meta MetaSchema
+	// Validate is an optional check run after the identity and meta checks
+	Validate func(*db.Event) error
+	// Precondition is an optional check against the events recorded for the call up to the
+	// event's timestamp. It runs with the call locked, in the transaction that stores the
+	// event. Failures wrapping ErrPrecondition reject the event.
+	Precondition func(ctx context.Context, e *db.Event, recorded []*db.Event) error
+	// Effects run in order after the event is recorded. They are best effort: the event is
+	// already stored, so a failing effect is reported to the registry's effect error handler
+	// and neither stops the effects after it nor fails the event.
+	Effects []EventEffect
+}
+
+// MetaSchema describes the meta an event type carries
+type MetaSchema struct {
+	// Required rejects events without meta
+	Required bool
+	// JSON requires meta, when present, to be a JSON object
+	JSON bool
+	// Keys are top level keys a JSON meta object must contain
+	Keys []string
+}
+
+// ErrPrecondition is wrapped by precondition failures caused by the event rather than the store
+var ErrPrecondition = errors.New("event precondition failed")
+
+// EventEffect is a side effect of recording an event
+type EventEffect func(context.Context, *db.Event) error
+
+// EffectErrorHandler is told about each event effect that failed
+type EffectErrorHandler func(context.Context, *db.Event, error)
+
+// EventRegistry holds the event types RecordEvent accepts. It is safe for concurrent use.
+type EventRegistry struct {
+	mu            sync.RWMutex
+	types         map[string]*EventType
+	onEffectError EffectErrorHandler
+}
+
+// NewEventRegistry creates a registry holding types
+func NewEventRegistry(types ...*EventType) (*EventRegistry, error) {
+	r := &EventRegistry{types: map[string]*EventType{}}
+	for _, t := range types {
+		if err := r.Register(t); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Register adds an event type, failing if its name is empty or already registered
+func (r *EventRegistry) Register(t *EventType) error {
+	if t.Name == "" {
+		return errors.New("event type name is required")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.types[t.Name]; ok {
+		return errors.New("event type already registered - " + t.Name)
+	}
+	r.types[t.Name] = t
+	return nil
+}
+
+// Attach appends effects to a registered event type, to run after the effects it was
+// registered with. It fails if no type is registered with name.
+func (r *EventRegistry) Attach(name string, effects ...EventEffect) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	t, ok := r.types[name]
+	if !ok {
+		return errors.New("event type not registered - " + name)
+	}
+
+	// copy the type so events already being recorded keep the effects they started with
+	attached := *t
+	attached.Effects = append(append([]EventEffect{}, t.Effects...), effects...)
+	r.types[name] = &attached
+	return nil
+}
+
+// HandleEffectErrors sets the handler failed effects are reported to. Without one they are dropped.
+func (r *EventRegistry) HandleEffectErrors(handler EffectErrorHandler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.onEffectError = handler
+}
+
+// effectFailed reports a failed effect to the effect error handler
+func (r *EventRegistry) effectFailed(ctx context.Context, e *db.Event, err error) {
+	r.mu.RLock()
+	handler := r.onEffectError
+	r.mu.RUnlock()
+
+	if handler != nil {
+		handler(ctx, e, err)
+	}
+}
+
+// Lookup returns the registered event type with name
+func (r *EventRegistry) Lookup(name string) (*EventType, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	t, ok := r.types[name]
+	return t, ok
+}
+
+// Names lists the registered event type names in sorted order
+func (r *EventRegistry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.types))
+	for name := range r.types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// check validates an event against its type
+func (t *EventType) check(e *db.Event) error {
+	if t.RequireIdentity && e.IdentityID == 0 {
+		return errors.New(t.Name + " events require an identity_id")
+	}
+
+	if err := t.Meta.check(e.Meta); err != nil {
+		return errors.Wrap(err, t.Name+" event meta")
+	}
+
+	if t.Validate != nil {
+		return t.Validate(e)
+	}
+	return nil
+}
+
+// check validates meta against the schema
+func (s MetaSchema) check(meta string) error {
+	if meta == "" {
+		if s.Required {
+			return errors.New("is required")
+		}
+		return nil
+	}
+
+	if !s.JSON {
+		return nil
+	}
+
+	obj := map[string]json.RawMessage{}
+	if err := json.Unmarshal([]byte(meta), &obj); err != nil {
+		return errors.New("must be a JSON object")
+	}
+	for _, k := range s.Keys {
+		if _, ok := obj[k]; !ok {
+			return errors.New("is missing key " + k)
+		}
+	}
+	return nil
+}
+
+// CompletesCall is an effect that finalizes the call's CDR once the call has completed
+func CompletesCall(completer callCompleter) EventEffect {
+	return func(ctx context.Context, e *db.Event) error {
+		_, err := completer.Complete(ctx, e.CallID)
+		return err
+	}
+}
+
+// BuiltinEventTypes are the event types that have a dedicated RPC. A disconnect classifies
+// the call's outcome, then schedules a callback if the caller abandoned, before the call's
+// CDR is finalized.
+func BuiltinEventTypes(completer callCompleter, classifier callClassifier, callbacks callbackScheduler) []*EventType {
+	return []*EventType{
+		{Name: DIAL},
+		{Name: RING},
+		{Name: CONNECT},
+		{Name: DISCONNECT, Effects: []EventEffect{
+			ClassifiesOutcome(classifier), SchedulesAbandonedCallback(callbacks), CompletesCall(completer),
+		}},
+		{Name: JOIN, RequireIdentity: true},
+		{Name: EXIT, RequireIdentity: true},
+		{Name: DISPO, Meta: MetaSchema{Required: true}, Effects: []EventEffect{CompletesCall(completer)}},
+		{Name: ENQUEUE},
+	}
+}
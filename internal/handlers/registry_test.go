@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeEventStore struct {
+	created []*db.Event
+}
+
+func (f *fakeEventStore) Create(ctx context.Context, e *db.Event) error {
+	f.created = append(f.created, e)
+	return nil
+}
+
+func (f *fakeEventStore) CreateChecked(ctx context.Context, e *db.Event, check func([]*db.Event) error) error {
+	if err := check(f.created); err != nil {
+		return err
+	}
+	return f.Create(ctx, e)
+}
+
+func TestRecordEvent(t *testing.T) {
+	effects := []int64{}
+	registry, err := NewEventRegistry(
+		&EventType{Name: "hold", RequireIdentity: true},
+		&EventType{
+			Name: "transfer",
+			Meta: MetaSchema{Required: true, JSON: true, Keys: []string{"to"}},
+			Effects: []EventEffect{func(ctx context.Context, e *db.Event) error {
+				effects = append(effects, e.CallID)
+				return nil
+			}},
+		},
+	)
+	if ok := assert.NoError(t, err, "Expected no error"); !ok {
+		assert.FailNow(t, "test setup failed")
+	}
+
+	request := func(eventType string, identityID int64, meta string) *pb.EventRequest {
+		return &pb.EventRequest{Event: &pb.Event{CallId: 1000, IdentityId: identityID, Timestamp: 100, Meta: meta, EventType: eventType}}
+	}
+
+	// ensures that a valid event is stored with its type and its effects run
+	t.Run("Registered type", func(t *testing.T) {
+		store := &fakeEventStore{}
+		resp, err := RecordEvent(context.Background(), request("transfer", 0, `{"to": "sales"}`), store, registry)
+		assert.NoError(t, err, "Expected no error")
+		assert.Equal(t, "transfer", resp.GetType(), "Expected the event type")
+		assert.Len(t, store.created, 1, "Expected the event to be stored")
+		assert.Equal(t, []int64{1000}, effects, "Expected the effect to run")
+	})
+
+	// ensures that invalid events are rejected before they are stored
+	for name, in := range map[string]*pb.EventRequest{
+		"Unknown type":     request("voicemail", 7, ""),
+		"Missing identity": request("hold", 0, ""),
+		"Missing meta":     request("transfer", 0, ""),
+		"Meta not JSON":    request("transfer", 0, "sales"),
+		"Meta missing key": request("transfer", 0, `{"from": "sales"}`),
+	} {
+		t.Run(name, func(t *testing.T) {
+			store := &fakeEventStore{}
+			_, err := RecordEvent(context.Background(), in, store, registry)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), "Expected an invalid argument error")
+			assert.Empty(t, store.created, "Expected nothing stored")
+		})
+	}
+
+	// ensures that a type cannot be registered twice
+	t.Run("Duplicate type", func(t *testing.T) {
+		err := registry.Register(&EventType{Name: "hold"})
+		assert.Error(t, err, "Expected a duplicate error")
+	})
+}
+
+func TestRecordEvent_effects(t *testing.T) {
+	ran := []string{}
+	effect := func(name string, err error) EventEffect {
+		return func(ctx context.Context, e *db.Event) error {
+			ran = append(ran, name)
+			return err
+		}
+	}
+	registry, err := NewEventRegistry(
+		&EventType{Name: "dispo", Effects: []EventEffect{effect("first", errors.New("bad config")), effect("second", nil)}},
+	)
+	if ok := assert.NoError(t, err, "Expected no error"); !ok {
+		assert.FailNow(t, "test setup failed")
+	}
+	failed := []error{}
+	registry.HandleEffectErrors(func(ctx context.Context, e *db.Event, err error) {
+		failed = append(failed, err)
+	})
+
+	// ensures that a failing effect neither fails the recorded event nor stops later effects
+	store := &fakeEventStore{}
+	resp, err := RecordEvent(context.Background(), &pb.EventRequest{Event: &pb.Event{CallId: 1000, EventType: "dispo"}}, store, registry)
+	assert.NoError(t, err, "Expected the event to be recorded despite the failed effect")
+	assert.Equal(t, int64(1000), resp.GetCallId(), "Expected the recorded event")
+	assert.Len(t, store.created, 1, "Expected the event to be stored once")
+	assert.Equal(t, []string{"first", "second"}, ran, "Expected every effect to run")
+	assert.Len(t, failed, 1, "Expected the failed effect to be reported")
+}
+
+func TestLegacyEvents(t *testing.T) {
+	// the builtin effects are not under test
+	types := BuiltinEventTypes(nil, nil, nil)
+	for _, typ := range types {
+		typ.Effects = nil
+	}
+	registry, err := NewEventRegistry(types...)
+	if ok := assert.NoError(t, err, "Expected no error"); !ok {
+		assert.FailNow(t, "test setup failed")
+	}
+
+	// ensures that the dedicated RPCs accept the events they accepted before event type schemas
+	for name, record := range map[string]func(context.Context, *pb.EventRequest, eventMethods, *EventRegistry) (*pb.EventResponse, error){
+		"Joined":        Joined,
+		"Exited":        Exited,
+		"Dispositioned": Dispositioned,
+	} {
+		t.Run(name, func(t *testing.T) {
+			store := &fakeEventStore{}
+			_, err := record(context.Background(), &pb.EventRequest{Event: &pb.Event{}}, store, registry)
+			assert.NoError(t, err, "Expected the legacy event to be accepted")
+			assert.Len(t, store.created, 1, "Expected the event to be stored")
+		})
+	}
+
+	// ensures that RecordEvent still validates the same events
+	_, err = RecordEvent(context.Background(), &pb.EventRequest{Event: &pb.Event{CallId: 1000, EventType: JOIN}}, &fakeEventStore{}, registry)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "Expected RecordEvent to require an identity")
+}
@@ -0,0 +1,332 @@
+package handlers
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/internal/phone"
+	"github.com/caring/call-handling/pb"
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+// maxRoutingRules caps the rules of a single rule set
+const maxRoutingRules = 500
+
+type routingMethods interface {
+	Create(context.Context, *db.RoutingRuleSet) error
+	Get(context.Context, int64) (*db.RoutingRuleSet, error)
+	GetActive(context.Context) (*db.RoutingRuleSet, error)
+	List(context.Context, int) ([]*db.RoutingRuleSet, error)
+}
+
+// routedCall is a call as routing rules see it
+type routedCall struct {
+	ANIE164  string
+	DNISE164 string
+	At       time.Time
+
+	// history is the caller's earlier calls, looked up the first time a rule needs it
+	history func() (*pb.CallerHistory, error)
+}
+
+// callerHistoryOnce looks up a caller's history other than the call exclude at most once,
+// giving callers with an unknown number an empty history
+func callerHistoryOnce(ctx context.Context, aniE164 string, exclude int64, calls callerHistorian, events eventLister) func() (*pb.CallerHistory, error) {
+	var (
+		h      *pb.CallerHistory
+		err    error
+		looked bool
+	)
+	return func() (*pb.CallerHistory, error) {
+		if !looked {
+			looked = true
+			if aniE164 == "" {
+				h = &pb.CallerHistory{}
+			} else {
+				h, err = callerHistory(ctx, aniE164, exclude, calls, events)
+			}
+		}
+		return h, err
+	}
+}
+
+// evaluateRule reports the first condition of a rule that a call fails, or "" when the rule
+// matches. Conditions are checked cheapest first, so the caller's history is only looked up
+// for calls passing every other condition.
+func evaluateRule(cfg db.TenantConfig, r db.RoutingRule, c *routedCall) (string, error) {
+	if len(r.DNIS) > 0 && !contains(r.DNIS, c.DNISE164) {
+		return "DNIS not listed", nil
+	}
+
+	if len(r.ANIPrefixes) > 0 {
+		matched := false
+		for _, p := range r.ANIPrefixes {
+			if c.ANIE164 != "" && strings.HasPrefix(c.ANIE164, p) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return "ANI prefix not listed", nil
+		}
+	}
+
+	if r.Hours != nil {
+		open, err := NextOpen(db.TenantConfig{Timezone: cfg.Timezone, BusinessHours: r.Hours}, c.At)
+		if err != nil {
+			return "", err
+		}
+		within := open.Equal(c.At)
+		if within && r.OutsideHours {
+			return "within hours", nil
+		}
+		if !within && !r.OutsideHours {
+			return "outside hours", nil
+		}
+	}
+
+	if r.MinPriorCalls == 0 && r.RepeatWithinMinutes == 0 && len(r.LastDispositions) == 0 {
+		return "", nil
+	}
+	h, err := c.history()
+	if err != nil {
+		return "", err
+	}
+	if h.GetCalls() < int64(r.MinPriorCalls) {
+		return "fewer than " + strconv.Itoa(r.MinPriorCalls) + " prior calls", nil
+	}
+	if r.RepeatWithinMinutes > 0 &&
+		(h.GetLastCallId() == 0 || c.At.Sub(time.Unix(h.GetLastCallAt(), 0)) > time.Duration(r.RepeatWithinMinutes)*time.Minute) {
+		return "no call in the last " + strconv.Itoa(r.RepeatWithinMinutes) + " minutes", nil
+	}
+	if len(r.LastDispositions) > 0 && !contains(r.LastDispositions, h.GetLastDisposition()) {
+		return "last disposition not listed", nil
+	}
+
+	return "", nil
+}
+
+// Route decides the queue, priority and skills of a call from the first rule it matches,
+// in the active version of the tenant's rules unless a dry run names another
+func Route(ctx context.Context, in *pb.RouteRequest, store routingMethods, calls callerHistorian, events eventLister) (*pb.RoutingDecision, error) {
+	tenant, err := db.TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Unauthenticated)
+	}
+
+	if !in.GetDryRun() && (in.GetVersion() != 0 || len(in.GetRules()) > 0) {
+		return nil, errors.WithGrpcStatus(errors.New("only a dry run may set version or rules"), codes.InvalidArgument)
+	}
+	if in.GetVersion() != 0 && len(in.GetRules()) > 0 {
+		return nil, errors.WithGrpcStatus(errors.New("only one of version and rules may be set"), codes.InvalidArgument)
+	}
+
+	var set *db.RoutingRuleSet
+	switch {
+	case len(in.GetRules()) > 0:
+		rules, err := routingRules(tenant.Config, in.GetRules())
+		if err != nil {
+			return nil, errors.WithGrpcStatus(err, codes.InvalidArgument)
+		}
+		set = &db.RoutingRuleSet{Rules: rules}
+	case in.GetVersion() != 0:
+		if set, err = store.Get(ctx, in.GetVersion()); err != nil {
+			if errors.Is(err, db.ErrNotFound) {
+				return nil, errors.WithGrpcStatus(err, codes.NotFound)
+			}
+			return nil, errors.WithGrpcStatus(err, codes.Internal)
+		}
+	default:
+		if set, err = store.GetActive(ctx); err != nil {
+			if errors.Is(err, db.ErrNotFound) {
+				return nil, errors.WithGrpcStatus(errors.New("no routing rules have been saved"), codes.FailedPrecondition)
+			}
+			return nil, errors.WithGrpcStatus(err, codes.Internal)
+		}
+	}
+
+	call := &db.Call{ANI: in.GetANI(), DNIS: in.GetDNIS()}
+	if err = normalizeNumbers(ctx, call); err != nil {
+		return nil, err
+	}
+	c := &routedCall{ANIE164: call.ANIE164, DNISE164: call.DNISE164, At: time.Now()}
+	if in.GetTimestamp() > 0 {
+		c.At = time.Unix(in.GetTimestamp(), 0)
+	}
+	// a call routed after it was created is left out of its caller's history
+	c.history = callerHistoryOnce(ctx, c.ANIE164, in.GetCallId(), calls, events)
+
+	decision := &pb.RoutingDecision{Version: set.Version}
+	for i, r := range set.Rules {
+		reason, err := evaluateRule(tenant.Config, r, c)
+		if err != nil {
+			return nil, errors.WithGrpcStatus(err, codes.Internal)
+		}
+		if in.GetDryRun() {
+			decision.Trace = append(decision.Trace, &pb.RuleEvaluation{
+				RuleIndex: int32(i),
+				RuleName:  r.Name,
+				Matched:   reason == "",
+				Reason:    reason,
+			})
+		}
+		if reason == "" {
+			decision.Matched = true
+			decision.Queue, decision.Priority, decision.Skills = r.Queue, int32(r.Priority), r.Skills
+			decision.RuleIndex, decision.RuleName = int32(i), r.Name
+			break
+		}
+	}
+
+	return decision, nil
+}
+
+// routingRules validates requested rules, normalizing their DNIS with the tenant's region
+func routingRules(cfg db.TenantConfig, in []*pb.RoutingRule) ([]db.RoutingRule, error) {
+	if len(in) == 0 {
+		return nil, errors.New("at least one rule is required")
+	}
+	if len(in) > maxRoutingRules {
+		return nil, errors.New("a rule set may have at most " + strconv.Itoa(maxRoutingRules) + " rules")
+	}
+
+	rules := make([]db.RoutingRule, 0, len(in))
+	for i, r := range in {
+		errMsg := func(msg string) error { return errors.New("rule " + strconv.Itoa(i) + ": " + msg) }
+
+		if r.GetQueue() == "" {
+			return nil, errMsg("queue is required")
+		}
+		if r.GetPriority() < 0 || r.GetMinPriorCalls() < 0 || r.GetRepeatWithinMinutes() < 0 {
+			return nil, errMsg("priority, min_prior_calls and repeat_within_minutes may not be negative")
+		}
+
+		rule := db.RoutingRule{
+			Name:                r.GetName(),
+			ANIPrefixes:         r.GetANIPrefixes(),
+			OutsideHours:        r.GetOutsideHours(),
+			MinPriorCalls:       int(r.GetMinPriorCalls()),
+			RepeatWithinMinutes: int(r.GetRepeatWithinMinutes()),
+			LastDispositions:    r.GetLastDispositions(),
+			Queue:               r.GetQueue(),
+			Priority:            int(r.GetPriority()),
+			Skills:              r.GetSkills(),
+		}
+
+		for _, n := range r.GetDNIS() {
+			e164, err := phone.Normalize(n, cfg.DefaultRegion)
+			if err != nil {
+				return nil, errMsg(err.Error())
+			}
+			rule.DNIS = append(rule.DNIS, e164)
+		}
+		for _, p := range rule.ANIPrefixes {
+			if !prefixPattern.MatchString(p) {
+				return nil, errMsg("ANI prefixes must be + followed by digits")
+			}
+		}
+		if h := r.GetHours(); h != nil {
+			rule.Hours = &db.BusinessHours{Open: h.GetOpen(), Close: h.GetClose(), Days: h.GetDays()}
+			if _, err := NextOpen(db.TenantConfig{BusinessHours: rule.Hours}, time.Now()); err != nil {
+				return nil, errMsg(err.Error())
+			}
+		} else if rule.OutsideHours {
+			return nil, errMsg("outside_hours requires hours")
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// routingRuleSetProto casts a db rule set into a proto response object
+func routingRuleSetProto(set *db.RoutingRuleSet) *pb.RoutingRuleSet {
+	resp := &pb.RoutingRuleSet{Version: set.Version, CreatedBy: set.CreatedBy, CreatedAt: set.CreatedAt.Unix()}
+	for _, r := range set.Rules {
+		rule := &pb.RoutingRule{
+			Name:                r.Name,
+			DNIS:                r.DNIS,
+			ANIPrefixes:         r.ANIPrefixes,
+			OutsideHours:        r.OutsideHours,
+			MinPriorCalls:       int32(r.MinPriorCalls),
+			RepeatWithinMinutes: int32(r.RepeatWithinMinutes),
+			LastDispositions:    r.LastDispositions,
+			Queue:               r.Queue,
+			Priority:            int32(r.Priority),
+			Skills:              r.Skills,
+		}
+		if r.Hours != nil {
+			rule.Hours = &pb.RoutingHours{Open: r.Hours.Open, Close: r.Hours.Close, Days: r.Hours.Days}
+		}
+		resp.Rules = append(resp.Rules, rule)
+	}
+	return resp
+}
+
+// CreateRoutingRules saves rules as the tenant's next version, which becomes active
+func CreateRoutingRules(ctx context.Context, in *pb.RoutingRulesRequest, store routingMethods) (*pb.RoutingRuleSet, error) {
+	tenant, err := db.TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Unauthenticated)
+	}
+
+	if in.GetCreatedBy() == "" {
+		return nil, errors.WithGrpcStatus(errors.New("created_by is required"), codes.InvalidArgument)
+	}
+	rules, err := routingRules(tenant.Config, in.GetRules())
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.InvalidArgument)
+	}
+
+	set := &db.RoutingRuleSet{Rules: rules, CreatedBy: in.GetCreatedBy()}
+	if err = store.Create(ctx, set); err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	// read back for the creation time
+	if set, err = store.Get(ctx, set.Version); err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+	return routingRuleSetProto(set), nil
+}
+
+// GetRoutingRules fetches a version of the tenant's rules, the active one by default
+func GetRoutingRules(ctx context.Context, in *pb.GetRoutingRulesRequest, store routingMethods) (*pb.RoutingRuleSet, error) {
+	var (
+		set *db.RoutingRuleSet
+		err error
+	)
+	if in.GetVersion() != 0 {
+		set, err = store.Get(ctx, in.GetVersion())
+	} else {
+		set, err = store.GetActive(ctx)
+	}
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, errors.WithGrpcStatus(err, codes.NotFound)
+		}
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	return routingRuleSetProto(set), nil
+}
+
+// ListRoutingRuleVersions lists the most recent versions of the tenant's rules
+func ListRoutingRuleVersions(ctx context.Context, in *pb.ListRoutingRuleVersionsRequest, store routingMethods) (*pb.RoutingRuleVersionsResponse, error) {
+	sets, err := store.List(ctx, listLimit(in.GetLimit()))
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	resp := &pb.RoutingRuleVersionsResponse{}
+	for _, s := range sets {
+		resp.Versions = append(resp.Versions, routingRuleSetProto(s))
+	}
+	return resp, nil
+}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeRouting holds saved rule sets by version, the last is active
+type fakeRouting struct {
+	sets []*db.RoutingRuleSet
+}
+
+func (f *fakeRouting) Create(ctx context.Context, set *db.RoutingRuleSet) error {
+	set.Version = int64(len(f.sets) + 1)
+	f.sets = append(f.sets, set)
+	return nil
+}
+
+func (f *fakeRouting) Get(ctx context.Context, version int64) (*db.RoutingRuleSet, error) {
+	if version < 1 || int(version) > len(f.sets) {
+		return nil, db.ErrNotFound
+	}
+	return f.sets[version-1], nil
+}
+
+func (f *fakeRouting) GetActive(ctx context.Context) (*db.RoutingRuleSet, error) {
+	return f.Get(ctx, int64(len(f.sets)))
+}
+
+func (f *fakeRouting) List(ctx context.Context, limit int) ([]*db.RoutingRuleSet, error) {
+	return f.sets, nil
+}
+
+func TestRoute(t *testing.T) {
+	ctx := db.TenantToCtx(context.Background(), &db.Tenant{ID: "a", Config: db.TenantConfig{DefaultRegion: "US"}})
+	// a Wednesday, 10:00 UTC
+	at := time.Date(2020, 6, 3, 10, 0, 0, 0, time.UTC)
+
+	store := &fakeRouting{}
+	_, err := CreateRoutingRules(ctx, &pb.RoutingRulesRequest{CreatedBy: "ops", Rules: []*pb.RoutingRule{
+		{Name: "after hours", Hours: &pb.RoutingHours{Open: "08:00", Close: "18:00"}, OutsideHours: true, Queue: "voicemail"},
+		{Name: "unhappy repeat", DNIS: []string{"800-555-0100"}, RepeatWithinMinutes: 60, LastDispositions: []string{"complaint"},
+			Queue: "retention", Priority: 9, Skills: []string{"retention"}},
+		{Name: "texas", ANIPrefixes: []string{"+1512"}, Queue: "sales-tx", Skills: []string{"sales", "spanish"}},
+		{Name: "default", Queue: "sales"},
+	}}, store)
+	assert.NoError(t, err, "Expected no error")
+
+	calls := &fakeCalls{calls: []*db.Call{{ID: 1000, ANIE164: "+15125551234", CreatedAt: at.Add(-30 * time.Minute)}}}
+	events := &fakeHoldEvents{events: []*db.Event{{CallID: 1000, Type: DISPO, Timestamp: at.Add(-20 * time.Minute).Unix(), Meta: "complaint"}}}
+
+	// ensures that a repeat caller with a complaint is routed by the caller history rule
+	d, err := Route(ctx, &pb.RouteRequest{ANI: "512-555-1234", DNIS: "800-555-0100", Timestamp: at.Unix()}, store, calls, events)
+	assert.NoError(t, err, "Expected no error")
+	assert.Equal(t, &pb.RoutingDecision{Matched: true, Queue: "retention", Priority: 9, Skills: []string{"retention"},
+		RuleIndex: 1, RuleName: "unhappy repeat", Version: 1}, d, "Expected the repeat caller rule to route the call")
+
+	// ensures that a call routed after it was created is not its own caller's history
+	d, err = Route(ctx, &pb.RouteRequest{CallId: 1000, ANI: "512-555-1234", DNIS: "800-555-0100", Timestamp: at.Unix()}, store, calls, events)
+	assert.NoError(t, err, "Expected no error")
+	assert.Equal(t, "sales-tx", d.GetQueue(), "Expected the call not to count as a repeat of itself")
+
+	// ensures that a dry run traces each rule up to the match
+	d, err = Route(ctx, &pb.RouteRequest{ANI: "512-555-1234", DNIS: "800-555-0199", Timestamp: at.Unix(), DryRun: true}, store, calls, events)
+	assert.NoError(t, err, "Expected no error")
+	assert.Equal(t, "sales-tx", d.GetQueue(), "Expected the prefix rule to route the call")
+	assert.Equal(t, []*pb.RuleEvaluation{
+		{RuleIndex: 0, RuleName: "after hours", Reason: "within hours"},
+		{RuleIndex: 1, RuleName: "unhappy repeat", Reason: "DNIS not listed"},
+		{RuleIndex: 2, RuleName: "texas", Matched: true},
+	}, d.GetTrace(), "Expected a trace of each rule")
+
+	// ensures that a dry run can evaluate candidate rules without saving them
+	d, err = Route(ctx, &pb.RouteRequest{ANI: "512-555-1234", Timestamp: at.Add(10 * time.Hour).Unix(), DryRun: true,
+		Rules: []*pb.RoutingRule{{Name: "weekend", Hours: &pb.RoutingHours{Open: "00:00", Close: "23:59", Days: []string{"Sat", "Sun"}}, Queue: "weekend"}}},
+		store, calls, events)
+	assert.NoError(t, err, "Expected no error")
+	assert.False(t, d.GetMatched(), "Expected no candidate rule to match")
+	assert.Len(t, store.sets, 1, "Expected the candidate rules not to be saved")
+
+	// ensures that the active version cannot be bypassed outside a dry run
+	_, err = Route(ctx, &pb.RouteRequest{ANI: "512-555-1234", Version: 1}, store, calls, events)
+	s, _ := status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, s.Code(), "Expected an invalid argument error")
+
+	// ensures that invalid rules are not saved
+	_, err = CreateRoutingRules(ctx, &pb.RoutingRulesRequest{CreatedBy: "ops", Rules: []*pb.RoutingRule{{ANIPrefixes: []string{"1512%"}, Queue: "x"}}}, store)
+	s, _ = status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, s.Code(), "Expected an invalid argument error")
+}
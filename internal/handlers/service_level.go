@@ -0,0 +1,256 @@
+package handlers
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/internal/phone"
+	"github.com/caring/call-handling/pb"
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+const (
+	// the target of calls no configured target matches, 80% answered within 20 seconds
+	defaultAnswerSeconds = 20
+	defaultTargetPercent = 80
+	// defaultIntervalSeconds is the length of report intervals when none is requested
+	defaultIntervalSeconds = 1800
+	// maxIntervals bounds the number of intervals a single report is split into
+	maxIntervals = 2000
+)
+
+type serviceLevelEvents interface {
+	ListCallsWithEvent(context.Context, string, int64, int64) ([]*db.Event, error)
+}
+
+type serviceLevelCalls interface {
+	ListDNISWithEvent(context.Context, string, int64, int64) (map[int64]string, error)
+}
+
+// QueuedCall is how a single queued call was resolved
+type QueuedCall struct {
+	CallID     int64
+	Queue      string
+	DNISE164   string
+	EnqueuedAt int64
+	// WaitSeconds is the time from enqueue to answer, or to hang up when unanswered
+	WaitSeconds int64
+	// Outcome is the outcome of the call, see ClassifyOutcome
+	Outcome string
+}
+
+// QueuedCalls resolves the calls that were enqueued, skipping those still waiting. events
+// must be ordered by timestamp within each call, and may hold the events of several calls.
+func QueuedCalls(events []*db.Event, dnis map[int64]string, shortAbandonSeconds int64) []QueuedCall {
+	calls := []QueuedCall{}
+	for _, call := range splitCalls(events) {
+		m := ComputeMetrics(call)
+		if m.EnqueuedAt == 0 || (m.ConnectedAt == 0 && !hasEvent(call, DISCONNECT) && !hasEvent(call, VOICEMAIL)) {
+			continue
+		}
+
+		c := QueuedCall{
+			CallID:      call[0].CallID,
+			DNISE164:    dnis[call[0].CallID],
+			EnqueuedAt:  m.EnqueuedAt,
+			WaitSeconds: m.QueueSeconds,
+			Outcome:     ClassifyOutcome(call, shortAbandonSeconds),
+		}
+		// the queue is the first the call entered, as on its CDR
+		for _, e := range call {
+			if e.Type == ENQUEUE {
+				c.Queue = e.Meta
+				break
+			}
+		}
+		calls = append(calls, c)
+	}
+	return calls
+}
+
+// serviceLevelTarget finds the most specific target matching a queue and DNIS, preferring a
+// queue match over a DNIS match. Target DNIS are normalized in region.
+func serviceLevelTarget(targets []db.ServiceLevelTarget, queue, dnisE164, region string) db.ServiceLevelTarget {
+	var (
+		best  = db.ServiceLevelTarget{AnswerSeconds: defaultAnswerSeconds, Percent: defaultTargetPercent}
+		score = -1
+	)
+	for _, t := range targets {
+		s := 0
+		if t.Queue != "" {
+			if t.Queue != queue {
+				continue
+			}
+			s += 2
+		}
+		if t.DNIS != "" {
+			e164, err := phone.Normalize(t.DNIS, region)
+			if err != nil {
+				e164 = t.DNIS
+			}
+			if e164 != dnisE164 {
+				continue
+			}
+			s++
+		}
+		if s > score {
+			best, score = t, s
+		}
+	}
+	return best
+}
+
+// serviceLevelTally accumulates the calls of an interval
+type serviceLevelTally struct {
+	interval   *pb.ServiceLevelInterval
+	answerWait int64
+}
+
+func (t *serviceLevelTally) add(c QueuedCall, target db.ServiceLevelTarget) {
+	i := t.interval
+	if c.Outcome == OutcomeShortAbandon {
+		i.ShortAbandoned++
+		return
+	}
+
+	i.Offered++
+	switch c.Outcome {
+	case OutcomeAnswered:
+		i.Answered++
+		t.answerWait += c.WaitSeconds
+		if c.WaitSeconds <= int64(target.AnswerSeconds) {
+			i.AnsweredWithinTarget++
+		}
+	case OutcomeAbandonedInQueue, OutcomeAbandonedRinging:
+		i.Abandoned++
+	}
+	if c.WaitSeconds > i.LongestWaitSeconds {
+		i.LongestWaitSeconds = c.WaitSeconds
+	}
+}
+
+// finish computes the interval's rates against target
+func (t *serviceLevelTally) finish(target db.ServiceLevelTarget) {
+	i := t.interval
+	if i.Answered > 0 {
+		i.AvgSpeedOfAnswerSeconds = t.answerWait / int64(i.Answered)
+	}
+	if i.Offered > 0 {
+		i.ServiceLevelPercent = 100 * float64(i.AnsweredWithinTarget) / float64(i.Offered)
+		i.AbandonPercent = 100 * float64(i.Abandoned) / float64(i.Offered)
+		i.Met = i.ServiceLevelPercent >= target.Percent
+	}
+}
+
+// serviceLevelGroup accumulates the calls of a queue and DNIS
+type serviceLevelGroup struct {
+	group     *pb.ServiceLevelGroup
+	target    db.ServiceLevelTarget
+	total     *serviceLevelTally
+	intervals []*serviceLevelTally
+}
+
+// GetServiceLevelReport reports how quickly the calls enqueued within a time range were
+// answered against their service level targets, per queue and DNIS and per interval
+func GetServiceLevelReport(ctx context.Context, in *pb.ServiceLevelReportRequest, events serviceLevelEvents, calls serviceLevelCalls) (*pb.ServiceLevelReport, error) {
+	tenant, err := db.TenantFromCtx(ctx)
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	from, to := in.GetFrom(), in.GetTo()
+	if from <= 0 || to <= from {
+		return nil, errors.WithGrpcStatus(errors.New("a time range with from before to is required"), codes.InvalidArgument)
+	}
+	interval := in.GetIntervalSeconds()
+	if interval <= 0 {
+		interval = defaultIntervalSeconds
+	}
+	if (to-from+interval-1)/interval > maxIntervals {
+		return nil, errors.WithGrpcStatus(errors.New("too many intervals, request a longer interval or a shorter range"), codes.InvalidArgument)
+	}
+
+	var dnisFilter string
+	if in.GetDNIS() != "" {
+		if dnisFilter, err = phone.Normalize(in.GetDNIS(), tenant.Config.DefaultRegion); err != nil {
+			return nil, errors.WithGrpcStatus(err, codes.InvalidArgument)
+		}
+	}
+
+	recorded, err := events.ListCallsWithEvent(ctx, ENQUEUE, from, to)
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+	dnis, err := calls.ListDNISWithEvent(ctx, ENQUEUE, from, to)
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	type groupKey struct{ queue, dnis string }
+	groups := map[groupKey]*serviceLevelGroup{}
+
+	for _, c := range QueuedCalls(recorded, dnis, shortAbandonSeconds(tenant.Config)) {
+		if c.EnqueuedAt < from || c.EnqueuedAt >= to {
+			continue
+		}
+		if (in.GetQueue() != "" && c.Queue != in.GetQueue()) || (dnisFilter != "" && c.DNISE164 != dnisFilter) {
+			continue
+		}
+
+		key := groupKey{c.Queue, c.DNISE164}
+		g, ok := groups[key]
+		if !ok {
+			g = newServiceLevelGroup(tenant.Config, c.Queue, c.DNISE164, from, to, interval)
+			groups[key] = g
+		}
+		g.total.add(c, g.target)
+		g.intervals[(c.EnqueuedAt-from)/interval].add(c, g.target)
+	}
+
+	report := &pb.ServiceLevelReport{Groups: make([]*pb.ServiceLevelGroup, 0, len(groups))}
+	for _, g := range groups {
+		g.total.finish(g.target)
+		for _, t := range g.intervals {
+			t.finish(g.target)
+		}
+		report.Groups = append(report.Groups, g.group)
+	}
+	sort.Slice(report.Groups, func(i, j int) bool {
+		a, b := report.Groups[i], report.Groups[j]
+		if a.Queue != b.Queue {
+			return a.Queue < b.Queue
+		}
+		return a.DNISE164 < b.DNISE164
+	})
+
+	return report, nil
+}
+
+// newServiceLevelGroup creates the empty tallies of a queue and DNIS over [from, to)
+func newServiceLevelGroup(cfg db.TenantConfig, queue, dnisE164 string, from, to, interval int64) *serviceLevelGroup {
+	g := &serviceLevelGroup{
+		target: serviceLevelTarget(cfg.ServiceLevels, queue, dnisE164, cfg.DefaultRegion),
+		total:  &serviceLevelTally{interval: &pb.ServiceLevelInterval{Start: from, End: to}},
+	}
+	g.group = &pb.ServiceLevelGroup{
+		Queue:               queue,
+		DNISE164:            dnisE164,
+		TargetAnswerSeconds: int32(g.target.AnswerSeconds),
+		TargetPercent:       g.target.Percent,
+		Total:               g.total.interval,
+	}
+
+	for start := from; start < to; start += interval {
+		end := start + interval
+		if end > to {
+			end = to
+		}
+		t := &serviceLevelTally{interval: &pb.ServiceLevelInterval{Start: start, End: end}}
+		g.intervals = append(g.intervals, t)
+		g.group.Intervals = append(g.group.Intervals, t.interval)
+	}
+	return g
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeServiceLevelStore struct {
+	events []*db.Event
+	dnis   map[int64]string
+}
+
+func (f *fakeServiceLevelStore) ListCallsWithEvent(ctx context.Context, eventType string, from, to int64) ([]*db.Event, error) {
+	return f.events, nil
+}
+
+func (f *fakeServiceLevelStore) ListDNISWithEvent(ctx context.Context, eventType string, from, to int64) (map[int64]string, error) {
+	return f.dnis, nil
+}
+
+func TestServiceLevelTarget(t *testing.T) {
+	targets := []db.ServiceLevelTarget{
+		{AnswerSeconds: 30, Percent: 70},
+		{DNIS: "8005550100", AnswerSeconds: 25, Percent: 75},
+		{Queue: "sales", AnswerSeconds: 15, Percent: 85},
+		{Queue: "sales", DNIS: "+18005550100", AnswerSeconds: 10, Percent: 90},
+	}
+
+	tests := []struct {
+		name        string
+		queue, dnis string
+		want        int
+	}{
+		{"Queue and DNIS", "sales", "+18005550100", 10},
+		{"Queue over DNIS", "sales", "+18005550199", 15},
+		{"DNIS normalized", "support", "+18005550100", 25},
+		{"Catch all", "support", "+18005550199", 30},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := serviceLevelTarget(targets, tt.queue, tt.dnis, "US")
+			assert.Equal(t, tt.want, got.AnswerSeconds, "Expected the most specific target")
+		})
+	}
+
+	assert.Equal(t, float64(defaultTargetPercent), serviceLevelTarget(nil, "sales", "", "US").Percent, "Expected the default target")
+}
+
+func TestGetServiceLevelReport(t *testing.T) {
+	store := &fakeServiceLevelStore{
+		events: []*db.Event{
+			// answered within target
+			{CallID: 1, Type: ENQUEUE, Timestamp: 1000, Meta: "sales"},
+			{CallID: 1, Type: CONNECT, IdentityID: 7, Timestamp: 1010},
+			// answered late
+			{CallID: 2, Type: ENQUEUE, Timestamp: 1100, Meta: "sales"},
+			{CallID: 2, Type: CONNECT, IdentityID: 7, Timestamp: 1150},
+			{CallID: 2, Type: DISCONNECT, Timestamp: 1300},
+			// abandoned
+			{CallID: 3, Type: ENQUEUE, Timestamp: 2000, Meta: "sales"},
+			{CallID: 3, Type: DISCONNECT, Timestamp: 2090},
+			// short abandon
+			{CallID: 4, Type: ENQUEUE, Timestamp: 2100, Meta: "sales"},
+			{CallID: 4, Type: DISCONNECT, Timestamp: 2105},
+			// still waiting
+			{CallID: 5, Type: ENQUEUE, Timestamp: 2200, Meta: "sales"},
+			// another queue
+			{CallID: 6, Type: ENQUEUE, Timestamp: 1000, Meta: "billing"},
+			{CallID: 6, Type: CONNECT, IdentityID: 8, Timestamp: 1005},
+		},
+		dnis: map[int64]string{1: "+18005550100", 2: "+18005550100", 3: "+18005550100", 4: "+18005550100", 5: "+18005550100", 6: "+18005550100"},
+	}
+	ctx := db.TenantToCtx(context.Background(), &db.Tenant{ID: "tenant-a", Config: db.TenantConfig{DefaultRegion: "US"}})
+
+	report, err := GetServiceLevelReport(ctx, &pb.ServiceLevelReportRequest{From: 900, To: 2700, IntervalSeconds: 900, Queue: "sales"}, store, store)
+	assert.NoError(t, err, "Expected no error")
+	if ok := assert.Len(t, report.GetGroups(), 1, "Expected a single group for the queue"); !ok {
+		return
+	}
+
+	g := report.GetGroups()[0]
+	assert.Equal(t, "+18005550100", g.GetDNISE164(), "Expected the group's DNIS")
+	assert.Equal(t, int32(defaultAnswerSeconds), g.GetTargetAnswerSeconds(), "Expected the default target")
+
+	total := g.GetTotal()
+	assert.Equal(t, int32(3), total.GetOffered(), "Expected short abandons and waiting calls not offered")
+	assert.Equal(t, int32(1), total.GetShortAbandoned(), "Expected one short abandon")
+	assert.Equal(t, int32(1), total.GetAnsweredWithinTarget(), "Expected one call answered within target")
+	assert.InDelta(t, 100.0/3, total.GetServiceLevelPercent(), 0.0001, "Expected the service level")
+	assert.InDelta(t, 100.0/3, total.GetAbandonPercent(), 0.0001, "Expected the abandon rate")
+	assert.Equal(t, int64(30), total.GetAvgSpeedOfAnswerSeconds(), "Expected the average speed of answer")
+	assert.Equal(t, int64(90), total.GetLongestWaitSeconds(), "Expected the longest wait")
+	assert.False(t, total.GetMet(), "Expected the target missed")
+
+	if ok := assert.Len(t, g.GetIntervals(), 2, "Expected an interval per 15 minutes"); !ok {
+		return
+	}
+	assert.Equal(t, int32(2), g.GetIntervals()[0].GetAnswered(), "Expected the answered calls in the first interval")
+	assert.Equal(t, int32(1), g.GetIntervals()[1].GetAbandoned(), "Expected the abandoned call in the second interval")
+}
@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/caring/call-handling/internal/auth"
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+// TenantHeader is the request metadata key identifying the tenant a caller acts for
+const TenantHeader = "x-tenant-id"
+
+// AuthHeader is the request metadata key carrying a caller's bearer token. The role a caller
+// acts with is only ever taken from a token whose signature has been verified.
+const AuthHeader = "authorization"
+
+// AdminRole is the role allowed to make tenant wide changes such as erasing a caller
+const AdminRole = "admin"
+
+// untenantedMethods are full gRPC method names that may be called without a tenant
+var untenantedMethods = map[string]bool{
+	"/callhandling.Callhandling/Ping": true,
+}
+
+type tenantMethods interface {
+	Get(context.Context, string) (*db.Tenant, error)
+}
+
+type tokenVerifier interface {
+	Verify(string) (*auth.Claims, error)
+}
+
+// roleKey is the context key of the role from a caller's verified token
+type roleKey struct{}
+
+// NewTenantUnaryInterceptor resolves the tenant named in the request metadata and
+// scopes the request context to it, rejecting requests for unknown tenants. A bearer
+// token, when present, must verify and be issued for the same tenant.
+func NewTenantUnaryInterceptor(store tenantMethods, tokens tokenVerifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if untenantedMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		ctx, err := tenantScope(ctx, store, tokens)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// NewTenantStreamInterceptor is the streaming counterpart of NewTenantUnaryInterceptor
+func NewTenantStreamInterceptor(store tenantMethods, tokens tokenVerifier) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if untenantedMethods[info.FullMethod] {
+			return handler(srv, ss)
+		}
+
+		ctx, err := tenantScope(ss.Context(), store, tokens)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &tenantStream{ss, ctx})
+	}
+}
+
+// tenantScope looks up the tenant from incoming metadata and stores it in ctx, along with
+// the role from the caller's bearer token if one was sent
+func tenantScope(ctx context.Context, store tenantMethods, tokens tokenVerifier) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ids := md.Get(TenantHeader)
+	if len(ids) == 0 || ids[0] == "" {
+		return nil, errors.WithGrpcStatus(errors.New("missing "+TenantHeader+" metadata"), codes.Unauthenticated)
+	}
+
+	if values := md.Get(AuthHeader); len(values) > 0 {
+		token := strings.TrimPrefix(values[0], "Bearer ")
+		claims, err := tokens.Verify(token)
+		if err != nil || token == values[0] {
+			return nil, errors.WithGrpcStatus(errors.New("invalid bearer token"), codes.Unauthenticated)
+		}
+		if claims.TenantID != ids[0] {
+			return nil, errors.WithGrpcStatus(errors.New("the token was issued for another tenant"), codes.PermissionDenied)
+		}
+		ctx = context.WithValue(ctx, roleKey{}, claims.Role)
+	}
+
+	tenant, err := store.Get(ctx, ids[0])
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, errors.WithGrpcStatus(err, codes.PermissionDenied)
+		}
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	return db.TenantToCtx(ctx, tenant), nil
+}
+
+// requireAdmin rejects requests from callers whose verified token lacks the admin role
+func requireAdmin(ctx context.Context) error {
+	role, _ := ctx.Value(roleKey{}).(string)
+	if role == "" {
+		return errors.WithGrpcStatus(errors.New("a bearer token is required"), codes.Unauthenticated)
+	}
+	if role != AdminRole {
+		return errors.WithGrpcStatus(errors.New("the admin role is required"), codes.PermissionDenied)
+	}
+	return nil
+}
+
+// tenantStream overrides the context of a server stream with a tenant scoped one
+type tenantStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *tenantStream) Context() context.Context {
+	return s.ctx
+}
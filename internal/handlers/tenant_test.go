@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/caring/call-handling/internal/auth"
+	"github.com/caring/call-handling/internal/db"
+)
+
+// fakeTenants knows a single tenant "a"
+type fakeTenants struct{}
+
+func (fakeTenants) Get(ctx context.Context, id string) (*db.Tenant, error) {
+	if id != "a" {
+		return nil, db.ErrNotFound
+	}
+	return &db.Tenant{ID: id}, nil
+}
+
+func TestTenantScope(t *testing.T) {
+	tokens, _ := auth.NewVerifier(bytes.Repeat([]byte{1}, 32))
+	exp := time.Now().Add(time.Hour).Unix()
+	admin, _ := tokens.Sign(&auth.Claims{TenantID: "a", Role: AdminRole, ExpiresAt: exp})
+	other, _ := tokens.Sign(&auth.Claims{TenantID: "b", Role: AdminRole, ExpiresAt: exp})
+	forger, _ := auth.NewVerifier(bytes.Repeat([]byte{2}, 32))
+	forged, _ := forger.Sign(&auth.Claims{TenantID: "a", Role: AdminRole, ExpiresAt: exp})
+
+	scope := func(pairs ...string) (context.Context, error) {
+		return tenantScope(metadata.NewIncomingContext(context.Background(), metadata.Pairs(pairs...)), fakeTenants{}, tokens)
+	}
+
+	// ensures that a request without a token is scoped to its tenant but has no role
+	ctx, err := scope(TenantHeader, "a")
+	assert.NoError(t, err, "Expected no error")
+	assert.Equal(t, codes.Unauthenticated, status.Code(requireAdmin(ctx)), "Expected no role without a token")
+
+	// ensures that a role stated in metadata is not trusted
+	ctx, err = scope(TenantHeader, "a", "x-caller-role", AdminRole)
+	assert.NoError(t, err, "Expected no error")
+	assert.Equal(t, codes.Unauthenticated, status.Code(requireAdmin(ctx)), "Expected a stated role to be ignored")
+
+	// ensures that the role of a verified token is used
+	ctx, err = scope(TenantHeader, "a", AuthHeader, "Bearer "+admin)
+	assert.NoError(t, err, "Expected no error")
+	assert.NoError(t, requireAdmin(ctx), "Expected the admin role from the token")
+
+	// ensures that a token that does not verify is refused
+	_, err = scope(TenantHeader, "a", AuthHeader, "Bearer "+forged)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "Expected a forged token to be refused")
+
+	// ensures that a token without the bearer scheme is refused
+	_, err = scope(TenantHeader, "a", AuthHeader, admin)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "Expected a bare token to be refused")
+
+	// ensures that a token is only good for its own tenant
+	_, err = scope(TenantHeader, "a", AuthHeader, "Bearer "+other)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "Expected another tenant's token to be denied")
+
+	// ensures that unknown tenants are denied
+	_, err = scope(TenantHeader, "c")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "Expected an unknown tenant to be denied")
+}
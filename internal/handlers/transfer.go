@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+// names of the transfer event types, see TransferEventTypes
+const (
+	TRANSFER_INITIATED = "transfer initiated"
+	TRANSFER_CONSULTED = "transfer consulted"
+	TRANSFER_COMPLETED = "transfer completed"
+	TRANSFER_FAILED    = "transfer failed"
+)
+
+// transfer kinds
+const (
+	// WarmTransfer is a transfer where the source agent consults the target before handing over
+	WarmTransfer = "warm"
+	// ColdTransfer is a transfer handed over without a consult
+	ColdTransfer = "cold"
+)
+
+// TransferMeta is the JSON meta of every transfer event
+type TransferMeta struct {
+	Kind string `json:"kind"`
+	// FromIdentity is the agent handing the call over, defaults to the event's identity
+	FromIdentity int64 `json:"from_identity,omitempty"`
+	// ToIdentity and ToQueue are the target, at least one is set unless the transfer failed
+	ToIdentity int64  `json:"to_identity,omitempty"`
+	ToQueue    string `json:"to_queue,omitempty"`
+	// LegCallID is the call created to reach the target, if the bridge placed a new call
+	LegCallID int64 `json:"leg_call_id,omitempty"`
+}
+
+type conversationLinker interface {
+	Link(context.Context, int64, int64) error
+	Start(context.Context, int64) (int64, error)
+}
+
+// ParseTransferMeta decodes the meta of a transfer event
+func ParseTransferMeta(e *db.Event) (*TransferMeta, error) {
+	m := &TransferMeta{}
+	if err := json.Unmarshal([]byte(e.Meta), m); err != nil {
+		return nil, errors.Wrap(err, "transfer meta")
+	}
+	if m.FromIdentity == 0 {
+		m.FromIdentity = e.IdentityID
+	}
+	return m, nil
+}
+
+// TransferEventTypes are the event types of warm and cold transfers. Transfer legs are
+// linked into the conversation of the transferred call.
+func TransferEventTypes(calls callGetter, conversations conversationLinker) []*EventType {
+	schema := MetaSchema{Required: true, JSON: true, Keys: []string{"kind"}}
+	link := []EventEffect{LinksTransferLeg(calls, conversations)}
+
+	return []*EventType{
+		{Name: TRANSFER_INITIATED, Meta: schema, Validate: validateTransfer(true), Effects: link},
+		{Name: TRANSFER_CONSULTED, Meta: schema, Validate: validateTransfer(true), Effects: link},
+		{Name: TRANSFER_COMPLETED, Meta: schema, Validate: validateTransfer(true), Effects: link},
+		{Name: TRANSFER_FAILED, Meta: schema, Validate: validateTransfer(false)},
+	}
+}
+
+// validateTransfer checks the kind of a transfer and, when requireTarget, that it has a target
+func validateTransfer(requireTarget bool) func(*db.Event) error {
+	return func(e *db.Event) error {
+		m, err := ParseTransferMeta(e)
+		if err != nil {
+			return err
+		}
+		if m.Kind != WarmTransfer && m.Kind != ColdTransfer {
+			return errors.New("transfer kind must be " + WarmTransfer + " or " + ColdTransfer)
+		}
+		if requireTarget && m.ToIdentity == 0 && m.ToQueue == "" {
+			return errors.New("transfer requires a to_identity or to_queue")
+		}
+		return nil
+	}
+}
+
+// LinksTransferLeg is an effect that links a transfer's leg into the conversation of the
+// transferred call, starting a new conversation if it had none
+func LinksTransferLeg(calls callGetter, conversations conversationLinker) EventEffect {
+	return func(ctx context.Context, e *db.Event) error {
+		m, err := ParseTransferMeta(e)
+		if err != nil || m.LegCallID == 0 || m.LegCallID == e.CallID {
+			return err
+		}
+
+		call, err := calls.Get(ctx, e.CallID)
+		if err != nil {
+			return err
+		}
+
+		conversationID, err := conversationOf(ctx, call, calls, conversations)
+		if err != nil {
+			return err
+		}
+
+		leg, err := calls.Get(ctx, m.LegCallID)
+		if err != nil {
+			return err
+		}
+		if leg.ConversationID == conversationID {
+			return nil
+		}
+
+		return conversations.Link(ctx, conversationID, leg.ID)
+	}
+}
+
+// conversationOf is the conversation of a call, starting a new one if it had none
+func conversationOf(ctx context.Context, call *db.Call, calls callGetter, conversations conversationLinker) (int64, error) {
+	if call.ConversationID != 0 {
+		return call.ConversationID, nil
+	}
+
+	ID, err := conversations.Start(ctx, call.ID)
+	if errors.Is(err, db.ErrNoRowsAffected) {
+		// another event started the conversation first
+		current, err := calls.Get(ctx, call.ID)
+		if err != nil {
+			return 0, err
+		}
+		ID = current.ConversationID
+	} else if err != nil {
+		return 0, err
+	}
+
+	call.ConversationID = ID
+	return ID, nil
+}
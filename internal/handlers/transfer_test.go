@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeConversationCalls struct {
+	calls map[int64]*db.Call
+}
+
+func (f *fakeConversationCalls) Get(ctx context.Context, ID int64) (*db.Call, error) {
+	return f.calls[ID], nil
+}
+
+func (f *fakeConversationCalls) Link(ctx context.Context, conversationID, callID int64) error {
+	f.calls[callID].ConversationID = conversationID
+	return nil
+}
+
+func (f *fakeConversationCalls) Start(ctx context.Context, callID int64) (int64, error) {
+	if f.calls[callID].ConversationID != 0 {
+		return 0, db.ErrNoRowsAffected
+	}
+	var ID int64
+	for _, c := range f.calls {
+		if c.ConversationID > ID {
+			ID = c.ConversationID
+		}
+	}
+	f.calls[callID].ConversationID = ID + 1
+	return ID + 1, nil
+}
+
+func TestLinksTransferLeg(t *testing.T) {
+	store := &fakeConversationCalls{calls: map[int64]*db.Call{
+		1: {ID: 1},
+		2: {ID: 2},
+		3: {ID: 3, ConversationID: 1},
+	}}
+	link := LinksTransferLeg(store, store)
+
+	// ensures that the leg joins a conversation started for the transferred call
+	err := link(context.Background(), &db.Event{CallID: 1, Type: TRANSFER_INITIATED, Meta: `{"kind": "cold", "to_queue": "billing", "leg_call_id": 2}`})
+	assert.NoError(t, err, "Expected no error")
+	assert.Equal(t, int64(2), store.calls[1].ConversationID, "Expected a new conversation rather than one keyed by the call's id")
+	assert.Equal(t, int64(2), store.calls[2].ConversationID, "Expected the leg in the same conversation")
+}
+
+func TestComputeMetrics_transfers(t *testing.T) {
+	// ensures that a warm transfer's consult is attributed to both agents
+	t.Run("Warm transfer", func(t *testing.T) {
+		m := ComputeMetrics([]*db.Event{
+			{Type: CONNECT, IdentityID: 7, Timestamp: 100},
+			{Type: TRANSFER_INITIATED, IdentityID: 7, Timestamp: 150, Meta: `{"kind": "warm", "to_identity": 8}`},
+			{Type: TRANSFER_CONSULTED, IdentityID: 7, Timestamp: 160, Meta: `{"kind": "warm", "to_identity": 8}`},
+			{Type: TRANSFER_COMPLETED, IdentityID: 7, Timestamp: 200, Meta: `{"kind": "warm", "to_identity": 8}`},
+			{Type: DISCONNECT, Timestamp: 300},
+		})
+
+		assert.Equal(t, map[int64]int64{7: 100, 8: 140}, m.AgentTalkSeconds, "Expected talk time per agent")
+		assert.Equal(t, 1, m.Transfers, "Expected one transfer")
+		assert.Equal(t, int64(200), m.TalkSeconds, "Expected the call's talk time to be unchanged")
+	})
+
+	// ensures that a cold transfer hands talk time over at completion
+	t.Run("Cold transfer", func(t *testing.T) {
+		m := ComputeMetrics([]*db.Event{
+			{Type: CONNECT, IdentityID: 7, Timestamp: 100},
+			{Type: TRANSFER_COMPLETED, IdentityID: 7, Timestamp: 130, Meta: `{"kind": "cold", "to_identity": 8}`},
+			{Type: DISCONNECT, Timestamp: 190},
+		})
+
+		assert.Equal(t, map[int64]int64{7: 30, 8: 60}, m.AgentTalkSeconds, "Expected talk time per agent")
+	})
+
+	// ensures that a failed consult stops the target's talk time
+	t.Run("Failed transfer", func(t *testing.T) {
+		m := ComputeMetrics([]*db.Event{
+			{Type: CONNECT, IdentityID: 7, Timestamp: 100},
+			{Type: TRANSFER_CONSULTED, IdentityID: 7, Timestamp: 110, Meta: `{"kind": "warm", "to_identity": 8}`},
+			{Type: TRANSFER_FAILED, IdentityID: 7, Timestamp: 120, Meta: `{"kind": "warm", "to_identity": 8}`},
+			{Type: DISCONNECT, Timestamp: 200},
+		})
+
+		assert.Equal(t, map[int64]int64{7: 100, 8: 10}, m.AgentTalkSeconds, "Expected talk time per agent")
+		assert.Equal(t, 0, m.Transfers, "Expected no completed transfer")
+	})
+}
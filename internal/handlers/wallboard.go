@@ -0,0 +1,275 @@
+package handlers
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/pb"
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+const (
+	// defaultWallboardInterval and minWallboardInterval bound the time between snapshots sent
+	// to a watcher. Snapshots are taken once per tenant every minWallboardInterval while it
+	// is watched, since each snapshot queries the day's queued calls.
+	defaultWallboardInterval = 5 * time.Second
+	minWallboardInterval     = 2 * time.Second
+	// wallboardLookback is how far back calls are searched for ones still waiting or in
+	// progress. Calls open for longer are left to the reaper.
+	wallboardLookback = 24 * time.Hour
+)
+
+type wallboardCalls interface {
+	serviceLevelCalls
+	CountOpen(context.Context, time.Time, time.Time, string, string) (int64, error)
+}
+
+type wallboardAgents interface {
+	List(context.Context, string) ([]*db.AgentState, error)
+}
+
+// Wallboard takes snapshots of a tenant's queues and agents, sharing them between every
+// watcher of the tenant
+type Wallboard struct {
+	events serviceLevelEvents
+	calls  wallboardCalls
+	agents wallboardAgents
+
+	// interval is the time between the snapshots of a watched tenant
+	interval time.Duration
+
+	mu    sync.Mutex
+	feeds map[string]*wallboardFeed
+}
+
+// wallboardFeed holds the latest snapshot of a watched tenant
+type wallboardFeed struct {
+	mu       sync.Mutex
+	snapshot *pb.Wallboard
+	err      error
+	// ready is closed once the first snapshot is taken
+	ready chan struct{}
+
+	watchers int
+	stop     context.CancelFunc
+}
+
+// NewWallboard creates a Wallboard reading from the given stores
+func NewWallboard(events serviceLevelEvents, calls wallboardCalls, agents wallboardAgents) *Wallboard {
+	return &Wallboard{
+		events:   events,
+		calls:    calls,
+		agents:   agents,
+		interval: minWallboardInterval,
+		feeds:    map[string]*wallboardFeed{},
+	}
+}
+
+// watch returns the feed of a tenant, starting one if the tenant is not watched yet. The
+// returned func must be called once the watcher is done, the last one stops the feed.
+func (w *Wallboard) watch(tenant *db.Tenant) (*wallboardFeed, func()) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	feed, ok := w.feeds[tenant.ID]
+	if !ok {
+		ctx, stop := context.WithCancel(db.TenantToCtx(context.Background(), tenant))
+		feed = &wallboardFeed{ready: make(chan struct{}), stop: stop}
+		w.feeds[tenant.ID] = feed
+		go w.publish(ctx, feed)
+	}
+	feed.watchers++
+
+	return feed, func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+
+		if feed.watchers--; feed.watchers == 0 {
+			feed.stop()
+			delete(w.feeds, tenant.ID)
+		}
+	}
+}
+
+// publish takes a snapshot into feed every interval until ctx is done
+func (w *Wallboard) publish(ctx context.Context, feed *wallboardFeed) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for first := true; ; first = false {
+		snapshot, err := w.Snapshot(ctx, time.Now())
+
+		feed.mu.Lock()
+		feed.snapshot, feed.err = snapshot, err
+		feed.mu.Unlock()
+		if first {
+			close(feed.ready)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// latest returns the latest snapshot of the feed, or the error taking it
+func (f *wallboardFeed) latest() (*pb.Wallboard, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.snapshot, f.err
+}
+
+// Snapshot takes a snapshot of the tenant in ctx as of now
+func (w *Wallboard) Snapshot(ctx context.Context, now time.Time) (*pb.Wallboard, error) {
+	tenant, err := db.TenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cfg := tenant.Config
+
+	from := now.Add(-wallboardLookback)
+	recorded, err := w.events.ListCallsWithEvent(ctx, ENQUEUE, from.Unix(), now.Unix()+1)
+	if err != nil {
+		return nil, err
+	}
+	dnis, err := w.calls.ListDNISWithEvent(ctx, ENQUEUE, from.Unix(), now.Unix()+1)
+	if err != nil {
+		return nil, err
+	}
+	inProgress, err := w.calls.CountOpen(ctx, from, now, CONNECT, DISCONNECT)
+	if err != nil {
+		return nil, err
+	}
+	agents, err := w.agents.List(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &pb.Wallboard{
+		Timestamp:       now.Unix(),
+		Queues:          []*pb.WallboardQueue{},
+		AgentsByState:   map[string]int32{},
+		CallsInProgress: int32(inProgress),
+	}
+	for _, a := range agents {
+		snapshot.AgentsByState[a.State]++
+	}
+
+	queues := map[string]*pb.WallboardQueue{}
+	queue := func(name string) *pb.WallboardQueue {
+		q, ok := queues[name]
+		if !ok {
+			q = &pb.WallboardQueue{Queue: name, Today: &pb.ServiceLevelInterval{}}
+			queues[name] = q
+		}
+		return q
+	}
+
+	// calls wait in the queue they were last sent to, since they were first enqueued
+	for _, call := range splitCalls(recorded) {
+		if hasEvent(call, CONNECT) || hasEvent(call, DISCONNECT) || hasEvent(call, VOICEMAIL) {
+			continue
+		}
+		var name string
+		for _, e := range call {
+			if e.Type == ENQUEUE {
+				name = e.Meta
+			}
+		}
+		q := queue(name)
+		q.Waiting++
+		if wait := span(ComputeMetrics(call).EnqueuedAt, now.Unix()); wait > q.LongestWaitSeconds {
+			q.LongestWaitSeconds = wait
+		}
+	}
+
+	y, m, d := now.In(tenantLocation(cfg)).Date()
+	dayStart := time.Date(y, m, d, 0, 0, 0, 0, tenantLocation(cfg)).Unix()
+
+	var (
+		total   = &serviceLevelTally{interval: &pb.ServiceLevelInterval{Start: dayStart, End: now.Unix()}}
+		tallies = map[string]*serviceLevelTally{}
+	)
+	for _, c := range QueuedCalls(recorded, dnis, shortAbandonSeconds(cfg)) {
+		if c.EnqueuedAt < dayStart {
+			continue
+		}
+		target := serviceLevelTarget(cfg.ServiceLevels, c.Queue, c.DNISE164, cfg.DefaultRegion)
+
+		t, ok := tallies[c.Queue]
+		if !ok {
+			t = &serviceLevelTally{interval: queue(c.Queue).Today}
+			t.interval.Start, t.interval.End = dayStart, now.Unix()
+			tallies[c.Queue] = t
+		}
+		t.add(c, target)
+		total.add(c, target)
+	}
+	for name, t := range tallies {
+		t.finish(serviceLevelTarget(cfg.ServiceLevels, name, "", cfg.DefaultRegion))
+	}
+	total.finish(serviceLevelTarget(cfg.ServiceLevels, "", "", cfg.DefaultRegion))
+	snapshot.Today = total.interval
+
+	for _, q := range queues {
+		snapshot.Queues = append(snapshot.Queues, q)
+	}
+	sort.Slice(snapshot.Queues, func(i, j int) bool { return snapshot.Queues[i].Queue < snapshot.Queues[j].Queue })
+
+	return snapshot, nil
+}
+
+// WatchWallboard streams the latest snapshot of the tenant's floor at every interval until
+// the client goes away. Snapshots are shared by every watcher of the tenant.
+func WatchWallboard(in *pb.WallboardRequest, stream pb.Callhandling_WatchWallboardServer, wallboard *Wallboard) error {
+	ctx := stream.Context()
+
+	tenant, err := db.TenantFromCtx(ctx)
+	if err != nil {
+		return errors.WithGrpcStatus(err, codes.Unauthenticated)
+	}
+
+	interval := time.Duration(in.GetIntervalSeconds()) * time.Second
+	if interval <= 0 {
+		interval = defaultWallboardInterval
+	}
+	if interval < minWallboardInterval {
+		interval = minWallboardInterval
+	}
+
+	feed, release := wallboard.watch(tenant)
+	defer release()
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case <-feed.ready:
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		snapshot, err := feed.latest()
+		if err != nil {
+			return errors.WithGrpcStatus(err, codes.Internal)
+		}
+		if err = stream.Send(snapshot); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeWallboardCalls struct {
+	fakeServiceLevelStore
+	open int64
+
+	mu        sync.Mutex
+	snapshots int
+}
+
+func (f *fakeWallboardCalls) CountOpen(ctx context.Context, from, to time.Time, startType, terminalType string) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.snapshots++
+	return f.open, nil
+}
+
+func TestWallboard_snapshot(t *testing.T) {
+	now := time.Date(2020, 6, 3, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) int64 { return now.Add(d).Unix() }
+
+	calls := &fakeWallboardCalls{open: 3}
+	calls.events = []*db.Event{
+		// answered yesterday
+		{CallID: 1, Type: ENQUEUE, Timestamp: at(-13 * time.Hour), Meta: "sales"},
+		{CallID: 1, Type: CONNECT, IdentityID: 7, Timestamp: at(-13*time.Hour + 5*time.Second)},
+		// answered today within target
+		{CallID: 2, Type: ENQUEUE, Timestamp: at(-time.Hour), Meta: "sales"},
+		{CallID: 2, Type: CONNECT, IdentityID: 7, Timestamp: at(-time.Hour + 10*time.Second)},
+		// waiting after moving to another queue
+		{CallID: 3, Type: ENQUEUE, Timestamp: at(-90 * time.Second), Meta: "sales"},
+		{CallID: 3, Type: ENQUEUE, Timestamp: at(-30 * time.Second), Meta: "billing"},
+		// waiting
+		{CallID: 4, Type: ENQUEUE, Timestamp: at(-40 * time.Second), Meta: "sales"},
+	}
+	agents := &fakeAgents{states: map[int64]*db.AgentState{
+		7: {IdentityID: 7, State: AgentOnCall},
+		8: {IdentityID: 8, State: AgentAvailable},
+		9: {IdentityID: 9, State: AgentAvailable},
+	}}
+	ctx := db.TenantToCtx(context.Background(), &db.Tenant{ID: "tenant-a", Config: db.TenantConfig{DefaultRegion: "US"}})
+
+	snapshot, err := NewWallboard(calls, calls, agents).Snapshot(ctx, now)
+	assert.NoError(t, err, "Expected no error")
+	assert.Equal(t, int32(3), snapshot.GetCallsInProgress(), "Expected the connected calls")
+	assert.Equal(t, map[string]int32{AgentOnCall: 1, AgentAvailable: 2}, snapshot.GetAgentsByState(), "Expected agents by state")
+	if ok := assert.Len(t, snapshot.GetQueues(), 2, "Expected both queues"); !ok {
+		return
+	}
+
+	billing, sales := snapshot.GetQueues()[0], snapshot.GetQueues()[1]
+	assert.Equal(t, int32(1), billing.GetWaiting(), "Expected the moved call waiting in its last queue")
+	assert.Equal(t, int64(90), billing.GetLongestWaitSeconds(), "Expected the wait since the call was first enqueued")
+	assert.Equal(t, int32(1), sales.GetWaiting(), "Expected one call waiting")
+	assert.Equal(t, int32(1), sales.GetToday().GetAnswered(), "Expected only today's answered call")
+	assert.Equal(t, float64(100), snapshot.GetToday().GetServiceLevelPercent(), "Expected today's service level")
+	assert.True(t, snapshot.GetToday().GetMet(), "Expected the target met")
+}
+
+func TestWallboard_watch(t *testing.T) {
+	calls := &fakeWallboardCalls{}
+	w := NewWallboard(calls, calls, &fakeAgents{states: map[int64]*db.AgentState{}})
+	w.interval = time.Hour
+	tenant := &db.Tenant{ID: "tenant-a"}
+
+	// ensures that every watcher of a tenant shares one feed and its snapshots
+	first, releaseFirst := w.watch(tenant)
+	second, releaseSecond := w.watch(tenant)
+	assert.True(t, first == second, "Expected a single feed for the tenant")
+	<-first.ready
+	calls.mu.Lock()
+	assert.Equal(t, 1, calls.snapshots, "Expected a single snapshot for both watchers")
+	calls.mu.Unlock()
+
+	// ensures that the feed stops with its last watcher
+	releaseFirst()
+	assert.Len(t, w.feeds, 1, "Expected the feed to outlive the first watcher")
+	releaseSecond()
+	assert.Empty(t, w.feeds, "Expected the feed to stop with the last watcher")
+}
@@ -0,0 +1,389 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/internal/phone"
+	"github.com/caring/call-handling/pb"
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+// webhook delivery statuses
+const (
+	WebhookPending   = "pending"
+	WebhookDelivered = "delivered"
+	WebhookDead      = "dead"
+	WebhookCancelled = "cancelled"
+)
+
+// minWebhookSecretLength is the shortest secret a subscription may sign with
+const minWebhookSecretLength = 16
+
+// webhookSubscriptionTTL is how long the dispatcher keeps a tenant's subscriptions, so that
+// recording an event does not query them. Changes to subscriptions apply to events recorded
+// once it has passed.
+const webhookSubscriptionTTL = 30 * time.Second
+
+var webhookStatuses = map[string]bool{
+	WebhookPending:   true,
+	WebhookDelivered: true,
+	WebhookDead:      true,
+	WebhookCancelled: true,
+}
+
+type webhookSubscriptionLister interface {
+	List(context.Context) ([]*db.WebhookSubscription, error)
+	CreateDeliveries(context.Context, []*db.WebhookDelivery) error
+}
+
+type webhookMethods interface {
+	webhookSubscriptionLister
+	Create(context.Context, *db.WebhookSubscription) error
+	Get(context.Context, int64) (*db.WebhookSubscription, error)
+	Update(context.Context, *db.WebhookSubscription) error
+	Delete(context.Context, int64) error
+	GetDelivery(context.Context, int64, int64) (*db.WebhookDelivery, error)
+	ListDeliveries(context.Context, int64, string, int) ([]*db.WebhookDelivery, error)
+	Redeliver(context.Context, int64, int64, int64) error
+}
+
+// WebhookPayload is the JSON body posted to a subscription for each event
+type WebhookPayload struct {
+	SubscriptionID int64        `json:"subscription_id"`
+	TenantID       string       `json:"tenant_id"`
+	Event          WebhookEvent `json:"event"`
+}
+
+// WebhookEvent is an event as delivered to subscriptions
+type WebhookEvent struct {
+	CallID     int64  `json:"call_id"`
+	IdentityID int64  `json:"identity_id,omitempty"`
+	Timestamp  int64  `json:"timestamp"`
+	EventType  string `json:"event_type"`
+	Meta       string `json:"meta,omitempty"`
+	DNISE164   string `json:"DNIS_e164,omitempty"`
+}
+
+// WebhookDispatcher queues a delivery of each recorded event to the subscriptions it matches
+type WebhookDispatcher struct {
+	subscriptions webhookSubscriptionLister
+	calls         callGetter
+
+	mu     sync.Mutex
+	active map[string]activeWebhookSubscriptions
+	now    func() time.Time
+}
+
+// activeWebhookSubscriptions are the subscriptions of a tenant that are not paused
+type activeWebhookSubscriptions struct {
+	subscriptions []*db.WebhookSubscription
+	expiresAt     time.Time
+}
+
+// NewWebhookDispatcher creates a WebhookDispatcher that queues deliveries in subscriptions
+func NewWebhookDispatcher(subscriptions webhookSubscriptionLister, calls callGetter) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		subscriptions: subscriptions,
+		calls:         calls,
+		active:        map[string]activeWebhookSubscriptions{},
+		now:           time.Now,
+	}
+}
+
+// Dispatch queues a delivery of an event to every active subscription whose filters it matches
+func (d *WebhookDispatcher) Dispatch(ctx context.Context, e *db.Event) error {
+	subscriptions, err := d.activeSubscriptions(ctx, e.TenantID)
+	if err != nil {
+		return err
+	}
+
+	matched := []*db.WebhookSubscription{}
+	for _, s := range subscriptions {
+		if len(s.EventTypes) == 0 || contains(s.EventTypes, e.Type) {
+			matched = append(matched, s)
+		}
+	}
+	if len(matched) == 0 {
+		return nil
+	}
+
+	call, err := d.calls.Get(ctx, e.CallID)
+	if err != nil {
+		return err
+	}
+
+	// the DNIS of an outbound call is the party called, which is not delivered
+	dnis := call.DNISE164
+	if call.Direction == Outbound {
+		dnis = ""
+	}
+
+	var (
+		deliveries = []*db.WebhookDelivery{}
+		now        = time.Now().Unix()
+	)
+	for _, s := range matched {
+		if len(s.DNISE164) > 0 && !contains(s.DNISE164, dnis) {
+			continue
+		}
+
+		payload, err := json.Marshal(WebhookPayload{
+			SubscriptionID: s.ID,
+			TenantID:       e.TenantID,
+			Event: WebhookEvent{
+				CallID:     e.CallID,
+				IdentityID: e.IdentityID,
+				Timestamp:  e.Timestamp,
+				EventType:  e.Type,
+				Meta:       e.Meta,
+				DNISE164:   dnis,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		deliveries = append(deliveries, &db.WebhookDelivery{
+			SubscriptionID: s.ID,
+			CallID:         e.CallID,
+			EventType:      e.Type,
+			Payload:        string(payload),
+			NextAttemptAt:  now,
+		})
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	return d.subscriptions.CreateDeliveries(ctx, deliveries)
+}
+
+// activeSubscriptions lists the subscriptions of a tenant that are not paused, listing them
+// from the store at most once every webhookSubscriptionTTL
+func (d *WebhookDispatcher) activeSubscriptions(ctx context.Context, tenantID string) ([]*db.WebhookSubscription, error) {
+	now := d.now()
+
+	d.mu.Lock()
+	cached, ok := d.active[tenantID]
+	d.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.subscriptions, nil
+	}
+
+	subscriptions, err := d.subscriptions.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	active := []*db.WebhookSubscription{}
+	for _, s := range subscriptions {
+		if !s.Paused {
+			active = append(active, s)
+		}
+	}
+
+	d.mu.Lock()
+	d.active[tenantID] = activeWebhookSubscriptions{subscriptions: active, expiresAt: now.Add(webhookSubscriptionTTL)}
+	d.mu.Unlock()
+
+	return active, nil
+}
+
+// AttachWebhooks makes every event type in registry queue deliveries to the subscriptions
+// it matches. Types registered afterwards are not delivered.
+func AttachWebhooks(registry *EventRegistry, dispatcher *WebhookDispatcher) error {
+	for _, name := range registry.Names() {
+		if err := registry.Attach(name, dispatcher.Dispatch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// contains reports whether any of values is v
+func contains(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+// webhookSubscription validates a subscription request into a subscription. The secret
+// may be empty when requireSecret is false.
+func webhookSubscription(ctx context.Context, in *pb.WebhookSubscriptionRequest, registry *EventRegistry, requireSecret bool) (*db.WebhookSubscription, error) {
+	tenant, err := db.TenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(in.GetUrl())
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return nil, errors.New("url must be an absolute https URL")
+	}
+
+	if (requireSecret || in.GetSecret() != "") && len(in.GetSecret()) < minWebhookSecretLength {
+		return nil, errors.New("secret must be at least 16 characters")
+	}
+
+	for _, t := range in.GetEventTypes() {
+		if _, ok := registry.Lookup(t); !ok {
+			return nil, errors.New("unknown event type - " + t)
+		}
+	}
+
+	dnis := make([]string, 0, len(in.GetDNIS()))
+	for _, n := range in.GetDNIS() {
+		e164, err := phone.Normalize(n, tenant.Config.DefaultRegion)
+		if err != nil {
+			return nil, err
+		}
+		dnis = append(dnis, e164)
+	}
+
+	return &db.WebhookSubscription{
+		ID:         in.GetSubscriptionId(),
+		URL:        u.String(),
+		EventTypes: in.GetEventTypes(),
+		DNISE164:   dnis,
+		Secret:     in.GetSecret(),
+		Paused:     in.GetPaused(),
+	}, nil
+}
+
+func CreateWebhookSubscription(ctx context.Context, in *pb.WebhookSubscriptionRequest, store webhookMethods, registry *EventRegistry) (*pb.WebhookSubscription, error) {
+	s, err := webhookSubscription(ctx, in, registry, true)
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.InvalidArgument)
+	}
+
+	if err = store.Create(ctx, s); err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	return getWebhookSubscription(ctx, s.ID, store)
+}
+
+func GetWebhookSubscription(ctx context.Context, in *pb.WebhookRequest, store webhookMethods) (*pb.WebhookSubscription, error) {
+	return getWebhookSubscription(ctx, in.GetSubscriptionId(), store)
+}
+
+// getWebhookSubscription fetches a single subscription as a proto response object
+func getWebhookSubscription(ctx context.Context, ID int64, store webhookMethods) (*pb.WebhookSubscription, error) {
+	s, err := store.Get(ctx, ID)
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, errors.WithGrpcStatus(err, codes.NotFound)
+		}
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	return s.ToProto(), nil
+}
+
+func ListWebhookSubscriptions(ctx context.Context, in *pb.ListWebhookSubscriptionsRequest, store webhookMethods) (*pb.WebhookSubscriptionsResponse, error) {
+	subscriptions, err := store.List(ctx)
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	resp := &pb.WebhookSubscriptionsResponse{Subscriptions: make([]*pb.WebhookSubscription, 0, len(subscriptions))}
+	for _, s := range subscriptions {
+		resp.Subscriptions = append(resp.Subscriptions, s.ToProto())
+	}
+	return resp, nil
+}
+
+// UpdateWebhookSubscription replaces a subscription, keeping its secret unless a new one is given
+func UpdateWebhookSubscription(ctx context.Context, in *pb.WebhookSubscriptionRequest, store webhookMethods, registry *EventRegistry) (*pb.WebhookSubscription, error) {
+	s, err := webhookSubscription(ctx, in, registry, false)
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.InvalidArgument)
+	}
+
+	current, err := store.Get(ctx, s.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, errors.WithGrpcStatus(err, codes.NotFound)
+		}
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+	if s.Secret == "" {
+		s.Secret = current.Secret
+	}
+
+	if err = store.Update(ctx, s); err != nil {
+		if errors.Is(err, db.ErrNoRowsAffected) {
+			return nil, errors.WithGrpcStatus(err, codes.NotFound)
+		}
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	return getWebhookSubscription(ctx, s.ID, store)
+}
+
+// DeleteWebhookSubscription deletes a subscription and cancels its pending deliveries,
+// returning the subscription as it was
+func DeleteWebhookSubscription(ctx context.Context, in *pb.WebhookRequest, store webhookMethods) (*pb.WebhookSubscription, error) {
+	resp, err := getWebhookSubscription(ctx, in.GetSubscriptionId(), store)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = store.Delete(ctx, in.GetSubscriptionId()); err != nil {
+		if errors.Is(err, db.ErrNoRowsAffected) {
+			return nil, errors.WithGrpcStatus(err, codes.NotFound)
+		}
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	return resp, nil
+}
+
+func ListWebhookDeliveries(ctx context.Context, in *pb.ListWebhookDeliveriesRequest, store webhookMethods) (*pb.WebhookDeliveriesResponse, error) {
+	if in.GetStatus() != "" && !webhookStatuses[in.GetStatus()] {
+		return nil, errors.WithGrpcStatus(errors.New("unknown delivery status - "+in.GetStatus()), codes.InvalidArgument)
+	}
+
+	deliveries, err := store.ListDeliveries(ctx, in.GetSubscriptionId(), in.GetStatus(), listLimit(in.GetLimit()))
+	if err != nil {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	resp := &pb.WebhookDeliveriesResponse{Deliveries: make([]*pb.WebhookDelivery, 0, len(deliveries))}
+	for _, d := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, d.ToProto())
+	}
+	return resp, nil
+}
+
+// RedeliverWebhook requeues a dead delivery for a fresh round of attempts
+func RedeliverWebhook(ctx context.Context, in *pb.RedeliverWebhookRequest, store webhookMethods) (*pb.WebhookDelivery, error) {
+	err := store.Redeliver(ctx, in.GetSubscriptionId(), in.GetDeliveryId(), time.Now().Unix())
+	if err != nil && !errors.Is(err, db.ErrNoRowsAffected) {
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+	requeued := err == nil
+
+	d, err := store.GetDelivery(ctx, in.GetSubscriptionId(), in.GetDeliveryId())
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, errors.WithGrpcStatus(err, codes.NotFound)
+		}
+		return nil, errors.WithGrpcStatus(err, codes.Internal)
+	}
+
+	if !requeued {
+		return nil, errors.WithGrpcStatus(errors.New("only dead deliveries can be redelivered, delivery is "+d.Status), codes.FailedPrecondition)
+	}
+
+	return d.ToProto(), nil
+}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeWebhookSubscriptions struct {
+	subscriptions []*db.WebhookSubscription
+	deliveries    []*db.WebhookDelivery
+	lists         int
+}
+
+func (f *fakeWebhookSubscriptions) List(ctx context.Context) ([]*db.WebhookSubscription, error) {
+	f.lists++
+	return f.subscriptions, nil
+}
+
+func (f *fakeWebhookSubscriptions) CreateDeliveries(ctx context.Context, deliveries []*db.WebhookDelivery) error {
+	f.deliveries = append(f.deliveries, deliveries...)
+	return nil
+}
+
+func TestWebhookDispatcher_Dispatch(t *testing.T) {
+	subscriptions := &fakeWebhookSubscriptions{subscriptions: []*db.WebhookSubscription{
+		{ID: 1},
+		{ID: 2, EventTypes: []string{CONNECT}},
+		{ID: 3, EventTypes: []string{DISCONNECT}},
+		{ID: 4, DNISE164: []string{"+18005550100"}},
+		{ID: 5, DNISE164: []string{"+18005550199"}},
+		{ID: 6, Paused: true},
+	}}
+	calls := &fakeCDRStore{call: &db.Call{ID: 1000, DNISE164: "+18005550100"}}
+	dispatcher := NewWebhookDispatcher(subscriptions, calls)
+
+	err := dispatcher.Dispatch(context.Background(), &db.Event{
+		TenantID: "a", CallID: 1000, IdentityID: 7, Type: CONNECT, Timestamp: 130,
+	})
+	assert.NoError(t, err, "Expected no error")
+
+	// ensures that only active subscriptions whose event type and DNIS filters match are queued
+	IDs := []int64{}
+	for _, d := range subscriptions.deliveries {
+		IDs = append(IDs, d.SubscriptionID)
+	}
+	assert.Equal(t, []int64{1, 2, 4}, IDs, "Expected deliveries to the matching subscriptions")
+
+	var payload WebhookPayload
+	assert.NoError(t, json.Unmarshal([]byte(subscriptions.deliveries[0].Payload), &payload), "Expected a JSON payload")
+	assert.Equal(t, WebhookPayload{
+		SubscriptionID: 1,
+		TenantID:       "a",
+		Event:          WebhookEvent{CallID: 1000, IdentityID: 7, Timestamp: 130, EventType: CONNECT, DNISE164: "+18005550100"},
+	}, payload, "Expected the event in the payload")
+
+	// ensures that the party called by an outbound call is neither matched nor delivered
+	subscriptions.deliveries = nil
+	calls.call = &db.Call{ID: 1001, DNISE164: "+18005550100", Direction: Outbound}
+	err = dispatcher.Dispatch(context.Background(), &db.Event{
+		TenantID: "a", CallID: 1001, IdentityID: 7, Type: CONNECT, Timestamp: 140,
+	})
+	assert.NoError(t, err, "Expected no error")
+	IDs = []int64{}
+	for _, d := range subscriptions.deliveries {
+		IDs = append(IDs, d.SubscriptionID)
+		assert.NotContains(t, d.Payload, "+18005550100", "Expected no called number in the payload")
+	}
+	assert.Equal(t, []int64{1, 2}, IDs, "Expected no deliveries to DNIS filtered subscriptions")
+}
+
+func TestWebhookDispatcher_activeSubscriptions(t *testing.T) {
+	subscriptions := &fakeWebhookSubscriptions{subscriptions: []*db.WebhookSubscription{{ID: 1}}}
+	dispatcher := NewWebhookDispatcher(subscriptions, &fakeCDRStore{call: &db.Call{ID: 1000}})
+	now := time.Unix(1000, 0)
+	dispatcher.now = func() time.Time { return now }
+	dispatch := func(tenantID string) {
+		err := dispatcher.Dispatch(context.Background(), &db.Event{TenantID: tenantID, CallID: 1000, Type: CONNECT})
+		assert.NoError(t, err, "Expected no error")
+	}
+
+	// ensures that subscriptions are listed once per tenant until they expire
+	dispatch("a")
+	dispatch("a")
+	assert.Equal(t, 1, subscriptions.lists, "Expected the tenant's subscriptions to be kept")
+	dispatch("b")
+	assert.Equal(t, 2, subscriptions.lists, "Expected each tenant's subscriptions to be listed")
+
+	now = now.Add(webhookSubscriptionTTL)
+	dispatch("a")
+	assert.Equal(t, 3, subscriptions.lists, "Expected expired subscriptions to be listed again")
+	assert.Len(t, subscriptions.deliveries, 4, "Expected every event delivered")
+}
@@ -0,0 +1,33 @@
+package phone
+
+import (
+	"github.com/nyaruka/phonenumbers"
+
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+// DefaultRegion is the region used to parse national numbers when a tenant has not configured one
+const DefaultRegion = "US"
+
+// ErrInvalidNumber occurs when a number cannot be parsed or is not a valid number in its region
+var ErrInvalidNumber = errors.New("invalid phone number")
+
+// Normalize parses a phone number as received from the telephony provider and returns
+// its E.164 form. National numbers are interpreted as belonging to region, an ISO 3166-1
+// alpha-2 code; numbers with a leading + are parsed independently of region.
+func Normalize(raw, region string) (string, error) {
+	if region == "" {
+		region = DefaultRegion
+	}
+
+	num, err := phonenumbers.Parse(raw, region)
+	if err != nil {
+		return "", errors.Wrap(ErrInvalidNumber, err.Error())
+	}
+
+	if !phonenumbers.IsValidNumber(num) {
+		return "", errors.WithStack(ErrInvalidNumber)
+	}
+
+	return phonenumbers.Format(num, phonenumbers.E164), nil
+}
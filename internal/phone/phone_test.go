@@ -0,0 +1,39 @@
+package phone
+
+import (
+	"testing"
+
+	"github.com/caring/go-packages/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	cases := []struct {
+		name     string
+		raw      string
+		region   string
+		expected string
+		err      error
+	}{
+		{"E.164 input", "+15125551234", "US", "+15125551234", nil},
+		{"National digits", "5125551234", "US", "+15125551234", nil},
+		{"Formatted national", "(512) 555-1234", "US", "+15125551234", nil},
+		{"Default region", "512.555.1234", "", "+15125551234", nil},
+		{"Foreign region", "020 7946 0018", "GB", "+442079460018", nil},
+		{"International ignores region", "+44 20 7946 0018", "US", "+442079460018", nil},
+		{"Too short", "555-1234", "US", "", ErrInvalidNumber},
+		{"Not a number", "anonymous", "US", "", ErrInvalidNumber},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			n, err := Normalize(c.raw, c.region)
+			if c.err != nil {
+				assert.True(t, errors.Is(err, c.err), "Expected an invalid number error")
+				return
+			}
+			assert.NoError(t, err, "Expected no error")
+			assert.Equal(t, c.expected, n, "Expected normalized numbers to match")
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// Package reaper disconnects calls whose disconnect event was lost.
+package reaper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/internal/handlers"
+	"github.com/caring/call-handling/pb"
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+// Reason prefixes the meta of every synthetic disconnect, which becomes the CDR's disconnect cause
+const Reason = "timeout"
+
+const (
+	// DefaultBatchSize is the number of stale calls fetched per query
+	DefaultBatchSize = 100
+	// DefaultPause is the wait between batches so a backlog does not starve live traffic
+	DefaultPause = 100 * time.Millisecond
+	// DefaultLookback is how far before the cutoff calls are searched, so calls whose
+	// events were purged by retention are not reaped long after the fact
+	DefaultLookback = 7 * 24 * time.Hour
+)
+
+type tenantMethods interface {
+	List(context.Context) ([]*db.Tenant, error)
+}
+
+type staleMethods interface {
+	ListStale(context.Context, time.Time, time.Time, string, int, int) ([]db.StaleCall, error)
+}
+
+// Recorder records an event of any registered type, running its side effects
+type Recorder func(context.Context, *pb.EventRequest) (*pb.EventResponse, error)
+
+// Report records what a run reaped for one tenant
+type Report struct {
+	TenantID string
+	Cutoff   time.Time
+	Calls    int64
+	// Skipped are the stale calls whose disconnect could not be recorded
+	Skipped int64
+}
+
+// Reaper records a synthetic disconnect for calls with no disconnect after their tenant's
+// maximum call duration. The disconnect is stamped with the call's last event, so the
+// lost tail of the call does not count towards its durations.
+type Reaper struct {
+	Tenants     tenantMethods
+	Calls       staleMethods
+	Record      Recorder
+	MaxDuration time.Duration
+	BatchSize   int
+	Pause       time.Duration
+	Lookback    time.Duration
+	// OnSkip is called with each stale call whose disconnect could not be recorded. The call
+	// is skipped for the rest of the run so it does not hold up the tenant's other calls.
+	OnSkip func(ctx context.Context, callID int64, err error)
+
+	now func() time.Time
+}
+
+// NewReaper creates a reaper for calls older than maxDuration with the default batch size,
+// pause and lookback
+func NewReaper(tenants tenantMethods, calls staleMethods, record Recorder, maxDuration time.Duration) *Reaper {
+	return &Reaper{
+		Tenants:     tenants,
+		Calls:       calls,
+		Record:      record,
+		MaxDuration: maxDuration,
+		BatchSize:   DefaultBatchSize,
+		Pause:       DefaultPause,
+		Lookback:    DefaultLookback,
+		now:         time.Now,
+	}
+}
+
+// Run reaps every tenant's stale calls and reports how many were reaped or skipped. A failure
+// for one tenant does not stop the others; the first error is returned alongside the reports.
+func (r *Reaper) Run(ctx context.Context) ([]Report, error) {
+	tenants, err := r.Tenants.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		reports  = []Report{}
+		firstErr error
+	)
+
+	for _, t := range tenants {
+		maxDuration := r.MaxDuration
+		if t.Config.MaxCallMinutes > 0 {
+			maxDuration = time.Duration(t.Config.MaxCallMinutes) * time.Minute
+		}
+
+		cutoff := r.now().Add(-maxDuration)
+		n, skipped, err := r.reap(db.TenantToCtx(ctx, t), cutoff, maxDuration)
+		if n > 0 || skipped > 0 {
+			reports = append(reports, Report{TenantID: t.ID, Cutoff: cutoff, Calls: n, Skipped: skipped})
+		}
+		if err != nil && firstErr == nil {
+			firstErr = errors.Wrap(err, "Error reaping stale calls for tenant "+t.ID)
+		}
+	}
+
+	return reports, firstErr
+}
+
+// reap disconnects batches of stale calls until a batch comes back short. Calls whose
+// disconnect was stored but whose side effects failed are counted as reaped. Calls whose
+// disconnect could not be recorded stay stale at the head of the list, so later batches
+// are fetched past them.
+func (r *Reaper) reap(ctx context.Context, cutoff time.Time, maxDuration time.Duration) (total, skipped int64, err error) {
+	meta := Reason + " - no disconnect within " + maxDuration.String()
+
+	for {
+		calls, err := r.Calls.ListStale(ctx, cutoff.Add(-r.Lookback), cutoff, handlers.DISCONNECT, int(skipped), r.BatchSize)
+		if err != nil {
+			return total, skipped, err
+		}
+
+		for _, c := range calls {
+			resp, err := r.Record(ctx, &pb.EventRequest{Event: &pb.Event{
+				CallId:    c.ID,
+				Timestamp: c.LastEventAt,
+				EventType: handlers.DISCONNECT,
+				Meta:      meta,
+			}})
+			if resp == nil {
+				skipped++
+				if r.OnSkip != nil {
+					r.OnSkip(ctx, c.ID, errors.Wrap(err, "Error reaping call "+strconv.FormatInt(c.ID, 10)))
+				}
+				continue
+			}
+			total++
+		}
+
+		if len(calls) < r.BatchSize {
+			return total, skipped, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return total, skipped, ctx.Err()
+		case <-time.After(r.Pause):
+		}
+	}
+}
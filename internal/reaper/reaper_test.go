@@ -0,0 +1,105 @@
+package reaper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/internal/handlers"
+	"github.com/caring/call-handling/pb"
+	"github.com/caring/go-packages/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeTenants []*db.Tenant
+
+func (f fakeTenants) List(context.Context) ([]*db.Tenant, error) {
+	return f, nil
+}
+
+// fakeCalls holds the stale calls of each tenant, which stop being stale once disconnected
+type fakeCalls struct {
+	stale   map[string][]db.StaleCall
+	cutoffs map[string]time.Time
+}
+
+func (f *fakeCalls) ListStale(ctx context.Context, from, to time.Time, terminalType string, offset, limit int) ([]db.StaleCall, error) {
+	t, _ := db.TenantFromCtx(ctx)
+	f.cutoffs[t.ID] = to
+	calls := f.stale[t.ID]
+	if offset > len(calls) {
+		offset = len(calls)
+	}
+	calls = calls[offset:]
+	if len(calls) > limit {
+		calls = calls[:limit]
+	}
+	return calls, nil
+}
+
+func (f *fakeCalls) record(ctx context.Context, in *pb.EventRequest) (*pb.EventResponse, error) {
+	t, _ := db.TenantFromCtx(ctx)
+	if in.GetEvent().GetCallId() == 99 {
+		return nil, errors.New("lock wait timeout")
+	}
+	for i, c := range f.stale[t.ID] {
+		if c.ID == in.GetEvent().GetCallId() {
+			f.stale[t.ID] = append(f.stale[t.ID][:i:i], f.stale[t.ID][i+1:]...)
+			break
+		}
+	}
+	return &pb.EventResponse{}, nil
+}
+
+func TestReaper_Run(t *testing.T) {
+	now := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	tenants := fakeTenants{
+		{ID: "a"},
+		{ID: "b", Config: db.TenantConfig{MaxCallMinutes: 30}},
+		{ID: "c"},
+	}
+	calls := &fakeCalls{
+		stale: map[string][]db.StaleCall{
+			"a": {{ID: 1, LastEventAt: 100}, {ID: 2, LastEventAt: 200}, {ID: 3, LastEventAt: 300}},
+			"b": {{ID: 4, LastEventAt: 400}},
+		},
+		cutoffs: map[string]time.Time{},
+	}
+
+	var recorded []*pb.Event
+	r := NewReaper(tenants, calls, func(ctx context.Context, in *pb.EventRequest) (*pb.EventResponse, error) {
+		recorded = append(recorded, in.GetEvent())
+		return calls.record(ctx, in)
+	}, 4*time.Hour)
+	r.BatchSize = 2
+	r.Pause = 0
+	r.now = func() time.Time { return now }
+
+	reports, err := r.Run(context.Background())
+	assert.NoError(t, err, "Expected no error")
+	assert.Equal(t, []Report{
+		{TenantID: "a", Cutoff: now.Add(-4 * time.Hour), Calls: 3},
+		{TenantID: "b", Cutoff: now.Add(-30 * time.Minute), Calls: 1},
+	}, reports, "Expected a report per tenant with stale calls")
+	assert.Equal(t, now.Add(-30*time.Minute), calls.cutoffs["b"], "Expected the tenant's max duration to apply")
+
+	// ensures that the synthetic disconnect is stamped with the call's last event and says why
+	assert.Equal(t, &pb.Event{CallId: 1, Timestamp: 100, EventType: handlers.DISCONNECT, Meta: "timeout - no disconnect within 4h0m0s"},
+		recorded[0], "Expected a timeout disconnect")
+
+	// ensures that a call that cannot be disconnected is skipped without blocking the calls after it
+	var skipped []int64
+	r.OnSkip = func(ctx context.Context, callID int64, err error) {
+		assert.Error(t, err, "Expected the record error")
+		skipped = append(skipped, callID)
+	}
+	calls.stale["a"] = []db.StaleCall{{ID: 99}, {ID: 5}, {ID: 6}, {ID: 7}}
+	reports, err = r.Run(context.Background())
+	assert.NoError(t, err, "Expected no error")
+	assert.Equal(t, []Report{
+		{TenantID: "a", Cutoff: now.Add(-4 * time.Hour), Calls: 3, Skipped: 1},
+	}, reports, "Expected the skipped call to be reported")
+	assert.Equal(t, []int64{99}, skipped, "Expected the skipped call to be reported once")
+	assert.Equal(t, []db.StaleCall{{ID: 99}}, calls.stale["a"], "Expected only the skipped call to remain stale")
+}
@@ -0,0 +1,41 @@
+package redact
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+// Error returns err with phone numbers masked from its message, keeping its gRPC status code
+func Error(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	st := status.Convert(err)
+	masked := Text(st.Message())
+	if masked == st.Message() {
+		return err
+	}
+
+	return errors.WithGrpcStatus(errors.New(masked), st.Code())
+}
+
+// UnaryServerInterceptor masks phone numbers in errors returned by handlers. It must be
+// chained after the logging and tracing interceptors so that they only see masked errors.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		return resp, Error(err)
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of UnaryServerInterceptor
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return Error(handler(srv, ss))
+	}
+}
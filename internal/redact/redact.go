@@ -0,0 +1,109 @@
+// Package redact masks caller PII before it reaches logs, traces, Sentry or error messages.
+//
+// Which fields count as sensitive is set by a Policy, installed process wide with SetPolicy.
+// Free text such as error messages is scrubbed of anything that looks like a phone number.
+package redact
+
+import (
+	"regexp"
+	"strings"
+	"sync/atomic"
+)
+
+// Redacted replaces sensitive values that are not phone numbers
+const Redacted = "[REDACTED]"
+
+// Field names understood by the policy, matching the db column names
+const (
+	ANI      = "ANI"
+	ANIE164  = "ANI_e164"
+	DNIS     = "DNIS"
+	DNISE164 = "DNIS_e164"
+	Meta     = "meta"
+)
+
+// phoneFields are masked keeping their last digits rather than redacted outright
+var phoneFields = map[string]bool{ANI: true, ANIE164: true, DNIS: true, DNISE164: true}
+
+// phonePattern matches E.164 numbers, NANP formatted numbers and bare 10-11 digit runs
+var phonePattern = regexp.MustCompile(`\+\d{7,15}|\(?\b\d{3}\)?[ .\-]?\d{3}[ .\-]\d{4}\b|\b1?\d{10}\b`)
+
+// Policy is the set of fields treated as sensitive
+type Policy struct {
+	sensitive map[string]bool
+}
+
+// DefaultPolicy treats the caller's number and provider metadata as sensitive
+var DefaultPolicy = NewPolicy(ANI, ANIE164, Meta)
+
+var current atomic.Value
+
+func init() {
+	current.Store(DefaultPolicy)
+}
+
+// NewPolicy creates a policy treating the named fields as sensitive
+func NewPolicy(fields ...string) *Policy {
+	p := &Policy{sensitive: map[string]bool{}}
+	for _, f := range fields {
+		if f = strings.TrimSpace(f); f != "" {
+			p.sensitive[f] = true
+		}
+	}
+	return p
+}
+
+// ParsePolicy creates a policy from a comma separated list of field names
+func ParsePolicy(fields string) *Policy {
+	return NewPolicy(strings.Split(fields, ",")...)
+}
+
+// SetPolicy installs the policy used by Field and the redacting String methods
+func SetPolicy(p *Policy) {
+	current.Store(p)
+}
+
+// Sensitive reports whether the installed policy treats field as sensitive
+func Sensitive(field string) bool {
+	return current.Load().(*Policy).sensitive[field]
+}
+
+// Field returns value masked according to the installed policy
+func Field(field, value string) string {
+	if value == "" || !Sensitive(field) {
+		return value
+	}
+	if phoneFields[field] {
+		return Phone(value)
+	}
+	return Redacted
+}
+
+// Phone masks all but the last four digits of a phone number
+func Phone(value string) string {
+	digits := 0
+	for _, r := range value {
+		if r >= '0' && r <= '9' {
+			digits++
+		}
+	}
+
+	b := strings.Builder{}
+	seen := 0
+	for _, r := range value {
+		if r >= '0' && r <= '9' {
+			seen++
+			if seen <= digits-4 {
+				b.WriteRune('*')
+				continue
+			}
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Text masks anything that looks like a phone number in free text
+func Text(s string) string {
+	return phonePattern.ReplaceAllStringFunc(s, Phone)
+}
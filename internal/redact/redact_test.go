@@ -0,0 +1,54 @@
+package redact
+
+import (
+	"testing"
+
+	"github.com/caring/go-packages/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPhone(t *testing.T) {
+	assert.Equal(t, "+*******1234", Phone("+15125551234"), "Expected E.164 numbers to keep their last four digits")
+	assert.Equal(t, "(***) ***-1234", Phone("(512) 555-1234"), "Expected formatting to be kept")
+	assert.Equal(t, "123", Phone("123"), "Expected short values to be kept")
+}
+
+func TestText(t *testing.T) {
+	cases := map[string]string{
+		"caller +15125551234 not found":      "caller +*******1234 not found",
+		"caller (512) 555-1234 not found":    "caller (***) ***-1234 not found",
+		"caller 512-555-1234 not found":      "caller ***-***-1234 not found",
+		"caller 5125551234 not found":        "caller ******1234 not found",
+		"Error executing get call - 1000":    "Error executing get call - 1000",
+		"Error executing get tenant - brand": "Error executing get tenant - brand",
+	}
+	for in, expected := range cases {
+		assert.Equal(t, expected, Text(in), "Expected phone numbers to be masked")
+	}
+}
+
+func TestField(t *testing.T) {
+	defer SetPolicy(DefaultPolicy)
+
+	assert.Equal(t, "+*******1234", Field(ANIE164, "+15125551234"), "Expected ANI to be masked by default")
+	assert.Equal(t, "+18005550100", Field(DNISE164, "+18005550100"), "Expected DNIS to be kept by default")
+	assert.Equal(t, Redacted, Field(Meta, "twilio_meta"), "Expected meta to be redacted by default")
+
+	SetPolicy(ParsePolicy("DNIS_e164, meta"))
+	assert.Equal(t, "+15125551234", Field(ANIE164, "+15125551234"), "Expected ANI to be kept")
+	assert.Equal(t, "+*******0100", Field(DNISE164, "+18005550100"), "Expected DNIS to be masked")
+}
+
+func TestError(t *testing.T) {
+	err := Error(errors.WithGrpcStatus(errors.New("caller +15125551234 blocked"), codes.PermissionDenied))
+	assert.Equal(t, "caller +*******1234 blocked", status.Convert(err).Message(), "Expected the message to be masked")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "Expected the status code to be kept")
+
+	err = Error(errors.New("Error executing create call - 5125551234"))
+	assert.Equal(t, codes.Unknown, status.Code(err), "Expected plain errors to have an unknown status")
+	assert.NotContains(t, err.Error(), "5125551234", "Expected plain errors to be masked")
+
+	assert.Nil(t, Error(nil), "Expected nil errors to stay nil")
+}
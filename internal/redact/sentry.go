@@ -0,0 +1,26 @@
+package redact
+
+import (
+	"github.com/getsentry/sentry-go"
+)
+
+// BeforeSend is a sentry.ClientOptions hook masking phone numbers in outgoing events
+func BeforeSend(event *sentry.Event, hint *sentry.EventHint) *sentry.Event {
+	event.Message = Text(event.Message)
+
+	for i := range event.Exception {
+		event.Exception[i].Value = Text(event.Exception[i].Value)
+	}
+
+	for _, b := range event.Breadcrumbs {
+		b.Message = Text(b.Message)
+	}
+
+	for k, v := range event.Extra {
+		if s, ok := v.(string); ok {
+			event.Extra[k] = Text(s)
+		}
+	}
+
+	return event
+}
@@ -0,0 +1,125 @@
+// Package retention purges call data that has outlived its tenant's retention period.
+package retention
+
+import (
+	"context"
+	"time"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+// Data classes with independent retention periods
+const (
+	ClassEvents = "events"
+	ClassMeta   = "meta"
+	ClassANI    = "ani"
+)
+
+const (
+	// DefaultBatchSize is the number of rows touched per statement, small enough to keep locks short
+	DefaultBatchSize = 1000
+	// DefaultPause is the wait between batches so purges do not starve live traffic
+	DefaultPause = 100 * time.Millisecond
+)
+
+type tenantMethods interface {
+	List(context.Context) ([]*db.Tenant, error)
+}
+
+type purgeMethods interface {
+	PurgeEvents(context.Context, time.Time, int) (int64, error)
+	PurgeEventMeta(context.Context, time.Time, int) (int64, error)
+	AnonymizeANI(context.Context, time.Time, int) (int64, error)
+}
+
+// Report records what a run purged for one tenant and data class
+type Report struct {
+	TenantID string
+	Class    string
+	Cutoff   time.Time
+	Rows     int64
+}
+
+// Purger applies each tenant's retention config
+type Purger struct {
+	Tenants   tenantMethods
+	Store     purgeMethods
+	BatchSize int
+	Pause     time.Duration
+
+	now func() time.Time
+}
+
+// NewPurger creates a purger with the default batch size and pause
+func NewPurger(tenants tenantMethods, store purgeMethods) *Purger {
+	return &Purger{
+		Tenants:   tenants,
+		Store:     store,
+		BatchSize: DefaultBatchSize,
+		Pause:     DefaultPause,
+		now:       time.Now,
+	}
+}
+
+// Run purges every tenant's expired data and reports what was purged. A failure for one
+// tenant does not stop the others; the first error is returned alongside the reports.
+func (p *Purger) Run(ctx context.Context) ([]Report, error) {
+	tenants, err := p.Tenants.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		reports  = []Report{}
+		firstErr error
+	)
+
+	for _, t := range tenants {
+		tctx := db.TenantToCtx(ctx, t)
+		r := t.Config.Retention
+
+		for _, class := range []struct {
+			name  string
+			days  int
+			purge func(context.Context, time.Time, int) (int64, error)
+		}{
+			{ClassEvents, r.EventsDays, p.Store.PurgeEvents},
+			{ClassMeta, r.MetaDays, p.Store.PurgeEventMeta},
+			{ClassANI, r.ANIDays, p.Store.AnonymizeANI},
+		} {
+			if class.days <= 0 {
+				continue
+			}
+
+			cutoff := p.now().AddDate(0, 0, -class.days)
+			rows, err := p.drain(tctx, cutoff, class.purge)
+			if rows > 0 {
+				reports = append(reports, Report{TenantID: t.ID, Class: class.name, Cutoff: cutoff, Rows: rows})
+			}
+			if err != nil && firstErr == nil {
+				firstErr = errors.Wrap(err, "Error purging "+class.name+" for tenant "+t.ID)
+			}
+		}
+	}
+
+	return reports, firstErr
+}
+
+// drain runs batches of purge until a batch comes back short
+func (p *Purger) drain(ctx context.Context, cutoff time.Time, purge func(context.Context, time.Time, int) (int64, error)) (int64, error) {
+	var total int64
+	for {
+		n, err := purge(ctx, cutoff, p.BatchSize)
+		total += n
+		if err != nil || n < int64(p.BatchSize) {
+			return total, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return total, ctx.Err()
+		case <-time.After(p.Pause):
+		}
+	}
+}
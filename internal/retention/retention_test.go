@@ -0,0 +1,85 @@
+package retention
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/go-packages/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeTenants []*db.Tenant
+
+func (f fakeTenants) List(context.Context) ([]*db.Tenant, error) {
+	return f, nil
+}
+
+// fakeStore has a fixed number of purgeable rows per tenant and class
+type fakeStore struct {
+	remaining map[string]int64
+	cutoffs   map[string]time.Time
+	fail      string
+}
+
+func (f *fakeStore) take(ctx context.Context, class string, cutoff time.Time, limit int) (int64, error) {
+	t, _ := db.TenantFromCtx(ctx)
+	key := t.ID + "/" + class
+	if key == f.fail {
+		return 0, errors.New("lock wait timeout")
+	}
+	f.cutoffs[key] = cutoff
+	n := f.remaining[key]
+	if n > int64(limit) {
+		n = int64(limit)
+	}
+	f.remaining[key] -= n
+	return n, nil
+}
+
+func (f *fakeStore) PurgeEvents(ctx context.Context, c time.Time, l int) (int64, error) {
+	return f.take(ctx, ClassEvents, c, l)
+}
+
+func (f *fakeStore) PurgeEventMeta(ctx context.Context, c time.Time, l int) (int64, error) {
+	return f.take(ctx, ClassMeta, c, l)
+}
+
+func (f *fakeStore) AnonymizeANI(ctx context.Context, c time.Time, l int) (int64, error) {
+	return f.take(ctx, ClassANI, c, l)
+}
+
+func TestPurger_Run(t *testing.T) {
+	now := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	tenants := fakeTenants{
+		{ID: "a", Config: db.TenantConfig{Retention: db.RetentionConfig{EventsDays: 90, ANIDays: 30}}},
+		{ID: "b", Config: db.TenantConfig{Retention: db.RetentionConfig{MetaDays: 7}}},
+		{ID: "c"},
+	}
+	store := &fakeStore{
+		remaining: map[string]int64{"a/events": 25, "a/ani": 3, "b/meta": 10, "c/events": 5},
+		cutoffs:   map[string]time.Time{},
+	}
+
+	p := NewPurger(tenants, store)
+	p.BatchSize = 10
+	p.Pause = 0
+	p.now = func() time.Time { return now }
+
+	reports, err := p.Run(context.Background())
+	assert.NoError(t, err, "Expected no error")
+	assert.Equal(t, []Report{
+		{TenantID: "a", Class: ClassEvents, Cutoff: now.AddDate(0, 0, -90), Rows: 25},
+		{TenantID: "a", Class: ClassANI, Cutoff: now.AddDate(0, 0, -30), Rows: 3},
+		{TenantID: "b", Class: ClassMeta, Cutoff: now.AddDate(0, 0, -7), Rows: 10},
+	}, reports, "Expected a report per purged class")
+	assert.Equal(t, int64(5), store.remaining["c/events"], "Expected tenants without retention to be kept")
+
+	// one failing tenant does not stop the others
+	store.remaining = map[string]int64{"a/ani": 4, "b/meta": 2}
+	store.fail = "a/events"
+	reports, err = p.Run(context.Background())
+	assert.Error(t, err, "Expected the purge error")
+	assert.Len(t, reports, 2, "Expected the other classes to be purged")
+}
@@ -0,0 +1,341 @@
+// Package webhook delivers queued webhook payloads to the subscriptions they were queued for.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/internal/handlers"
+	"github.com/caring/go-packages/pkg/errors"
+)
+
+// headers set on every delivery
+const (
+	// SignatureHeader carries t=<unix seconds>,v1=<hex HMAC-SHA256 of "<t>.<body>" keyed by the secret>
+	SignatureHeader = "X-Callhandling-Signature"
+	// DeliveryHeader carries the delivery id, which is the same on every attempt of a delivery
+	DeliveryHeader = "X-Callhandling-Delivery"
+	// EventHeader carries the event type of the payload
+	EventHeader = "X-Callhandling-Event"
+)
+
+const (
+	// DefaultBatchSize is the number of due deliveries fetched per query
+	DefaultBatchSize = 100
+	// DefaultMaxAttempts is the number of attempts after which a delivery is dead
+	DefaultMaxAttempts = 8
+	// DefaultBaseBackoff is the wait after the first failed attempt, doubling after each
+	// further one up to DefaultMaxBackoff
+	DefaultBaseBackoff = 30 * time.Second
+	DefaultMaxBackoff  = time.Hour
+	// DefaultTimeout bounds each attempt
+	DefaultTimeout = 10 * time.Second
+)
+
+// maxErrorLength is the longest error stored on a delivery
+const maxErrorLength = 1024
+
+// internalNetworks are the networks deliveries may not reach, so a subscription cannot be
+// pointed at the service's own network
+var internalNetworks = parseNetworks(
+	"0.0.0.0/8",      // this network
+	"10.0.0.0/8",     // RFC1918
+	"100.64.0.0/10",  // carrier-grade NAT
+	"127.0.0.0/8",    // loopback
+	"169.254.0.0/16", // link-local, including cloud metadata
+	"172.16.0.0/12",  // RFC1918
+	"192.0.0.0/24",   // IETF protocol assignments
+	"192.168.0.0/16", // RFC1918
+	"198.18.0.0/15",  // benchmarking
+	"224.0.0.0/4",    // multicast
+	"240.0.0.0/4",    // reserved and broadcast
+	"::/128",         // unspecified
+	"::1/128",        // loopback
+	"fc00::/7",       // unique local
+	"fe80::/10",      // link-local
+	"ff00::/8",       // multicast
+)
+
+// errInternalAddress fails an attempt whose subscription resolves to an internal network
+var errInternalAddress = errors.New("webhook url resolves to an internal address")
+
+type tenantMethods interface {
+	List(context.Context) ([]*db.Tenant, error)
+}
+
+type deliveryMethods interface {
+	Get(context.Context, int64) (*db.WebhookSubscription, error)
+	ListDue(context.Context, int64, int) ([]*db.WebhookDelivery, error)
+	Claim(context.Context, *db.WebhookDelivery, int64) error
+	RecordAttempt(context.Context, *db.WebhookDelivery, db.WebhookAttempt) error
+}
+
+// Report records what a run attempted for one tenant
+type Report struct {
+	TenantID  string
+	Delivered int64
+	Retried   int64
+	Dead      int64
+}
+
+// Deliverer posts due deliveries to their subscriptions, retrying failures with exponential
+// backoff until they are delivered or have used up their attempts and are dead
+type Deliverer struct {
+	Tenants     tenantMethods
+	Store       deliveryMethods
+	Client      *http.Client
+	BatchSize   int
+	MaxAttempts int
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+
+	now func() time.Time
+}
+
+// NewDeliverer creates a deliverer with the default batch size, attempts, backoff and timeout
+func NewDeliverer(tenants tenantMethods, store deliveryMethods) *Deliverer {
+	return &Deliverer{
+		Tenants:     tenants,
+		Store:       store,
+		Client:      newClient(DefaultTimeout),
+		BatchSize:   DefaultBatchSize,
+		MaxAttempts: DefaultMaxAttempts,
+		BaseBackoff: DefaultBaseBackoff,
+		MaxBackoff:  DefaultMaxBackoff,
+		now:         time.Now,
+	}
+}
+
+// newClient creates the client deliveries are posted with. It only dials public addresses,
+// checked after the host is resolved so a name cannot be rebound to an internal address,
+// and does not follow redirects, which would otherwise lead past that check.
+func newClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || isInternal(ip) {
+				return errInternalAddress
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// isInternal reports whether ip is in one of the internalNetworks
+func isInternal(ip net.IP) bool {
+	for _, n := range internalNetworks {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseNetworks parses CIDR blocks, panicking on an invalid one
+func parseNetworks(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, n)
+	}
+	return networks
+}
+
+// Sign computes the signature of a payload posted at timestamp, as sent in SignatureHeader
+func Sign(secret string, timestamp int64, body []byte) string {
+	ts := strconv.FormatInt(timestamp, 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts + "."))
+	mac.Write(body)
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Run attempts every tenant's due deliveries and reports the results. A failure for one
+// tenant does not stop the others; the first error is returned alongside the reports.
+func (d *Deliverer) Run(ctx context.Context) ([]Report, error) {
+	tenants, err := d.Tenants.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		reports  = []Report{}
+		firstErr error
+	)
+
+	for _, t := range tenants {
+		r := Report{TenantID: t.ID}
+		err := d.deliver(db.TenantToCtx(ctx, t), &r)
+		if r.Delivered+r.Retried+r.Dead > 0 {
+			reports = append(reports, r)
+		}
+		if err != nil && firstErr == nil {
+			firstErr = errors.Wrap(err, "Error delivering webhooks for tenant "+t.ID)
+		}
+	}
+
+	return reports, firstErr
+}
+
+// deliver attempts batches of due deliveries until a batch comes back short. Every attempt
+// moves a delivery's next attempt past now, so a batch is never fetched twice.
+func (d *Deliverer) deliver(ctx context.Context, r *Report) error {
+	for {
+		now := d.now()
+		due, err := d.Store.ListDue(ctx, now.Unix(), d.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, delivery := range due {
+			if err = d.attempt(ctx, delivery, r); err != nil {
+				return err
+			}
+		}
+
+		if len(due) < d.BatchSize {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+}
+
+// attempt leases a delivery, posts it and records the result
+func (d *Deliverer) attempt(ctx context.Context, delivery *db.WebhookDelivery, r *Report) error {
+	now := d.now()
+
+	subscription, err := d.Store.Get(ctx, delivery.SubscriptionID)
+	if err != nil && !errors.Is(err, db.ErrNotFound) {
+		return err
+	}
+	if subscription != nil && subscription.Paused {
+		// paused since the delivery was listed, it stays due for when the subscription resumes
+		return nil
+	}
+
+	// the lease outlives the attempt, so another worker only picks the delivery up if this one died
+	err = d.Store.Claim(ctx, delivery, now.Add(2*d.timeout()).Unix())
+	if errors.Is(err, db.ErrNoRowsAffected) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if subscription == nil {
+		return d.Store.RecordAttempt(ctx, delivery, db.WebhookAttempt{
+			Status: handlers.WebhookCancelled,
+			Error:  "subscription deleted",
+		})
+	}
+
+	code, err := d.post(ctx, subscription, delivery, now)
+	if err == nil {
+		r.Delivered++
+		return d.Store.RecordAttempt(ctx, delivery, db.WebhookAttempt{
+			Status:      handlers.WebhookDelivered,
+			StatusCode:  code,
+			DeliveredAt: d.now().Unix(),
+		})
+	}
+
+	attempt := db.WebhookAttempt{StatusCode: code, Error: truncate(err.Error(), maxErrorLength)}
+	if attempts := delivery.Attempts + 1; attempts < d.MaxAttempts {
+		attempt.Status = handlers.WebhookPending
+		attempt.NextAttemptAt = now.Add(d.backoff(attempts)).Unix()
+		r.Retried++
+	} else {
+		attempt.Status = handlers.WebhookDead
+		r.Dead++
+	}
+	return d.Store.RecordAttempt(ctx, delivery, attempt)
+}
+
+// post sends a delivery's signed payload, failing unless the subscription responds with a 2xx
+func (d *Deliverer) post(ctx context.Context, subscription *db.WebhookSubscription, delivery *db.WebhookDelivery, now time.Time) (int, error) {
+	body := []byte(delivery.Payload)
+
+	req, err := http.NewRequest(http.MethodPost, subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(subscription.Secret, now.Unix(), body))
+	req.Header.Set(DeliveryHeader, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(EventHeader, delivery.EventType)
+
+	resp, err := d.Client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// drain a little of the body so the connection can be reused
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, errors.New("unexpected response status " + resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// backoff is the wait after a delivery's nth failed attempt
+func (d *Deliverer) backoff(attempts int) time.Duration {
+	wait := d.BaseBackoff
+	for i := 1; i < attempts && wait < d.MaxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > d.MaxBackoff {
+		wait = d.MaxBackoff
+	}
+	return wait
+}
+
+// timeout is the longest an attempt may take
+func (d *Deliverer) timeout() time.Duration {
+	if d.Client.Timeout > 0 {
+		return d.Client.Timeout
+	}
+	return DefaultTimeout
+}
+
+// truncate shortens s to at most n bytes
+func truncate(s string, n int) string {
+	if len(s) > n {
+		return s[:n]
+	}
+	return s
+}
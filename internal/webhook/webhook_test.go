@@ -0,0 +1,159 @@
+package webhook
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/caring/call-handling/internal/db"
+	"github.com/caring/call-handling/internal/handlers"
+	"github.com/caring/go-packages/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeTenants []*db.Tenant
+
+func (f fakeTenants) List(context.Context) ([]*db.Tenant, error) {
+	return f, nil
+}
+
+// fakeDeliveries holds pending deliveries, which are due until claimed
+type fakeDeliveries struct {
+	subscriptions map[int64]*db.WebhookSubscription
+	pending       []*db.WebhookDelivery
+	attempts      map[int64]db.WebhookAttempt
+}
+
+func (f *fakeDeliveries) Get(ctx context.Context, ID int64) (*db.WebhookSubscription, error) {
+	s, ok := f.subscriptions[ID]
+	if !ok {
+		return nil, db.ErrNotFound
+	}
+	return s, nil
+}
+
+func (f *fakeDeliveries) ListDue(ctx context.Context, now int64, limit int) ([]*db.WebhookDelivery, error) {
+	due := []*db.WebhookDelivery{}
+	for _, d := range f.pending {
+		if s, ok := f.subscriptions[d.SubscriptionID]; ok && s.Paused {
+			continue
+		}
+		if d.NextAttemptAt <= now && len(due) < limit {
+			due = append(due, d)
+		}
+	}
+	return due, nil
+}
+
+func (f *fakeDeliveries) Claim(ctx context.Context, d *db.WebhookDelivery, leaseUntil int64) error {
+	d.NextAttemptAt = leaseUntil
+	return nil
+}
+
+func (f *fakeDeliveries) RecordAttempt(ctx context.Context, d *db.WebhookDelivery, attempt db.WebhookAttempt) error {
+	f.attempts[d.ID] = attempt
+	return nil
+}
+
+func TestDeliverer_Run(t *testing.T) {
+	now := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	var signatures []string
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		signatures = append(signatures, r.Header.Get(SignatureHeader))
+		assert.Equal(t, Sign("ok-secret-0123456789", now.Unix(), body), r.Header.Get(SignatureHeader), "Expected a valid signature")
+		assert.Equal(t, "1", r.Header.Get(DeliveryHeader), "Expected the delivery id")
+		assert.Equal(t, handlers.CONNECT, r.Header.Get(EventHeader), "Expected the event type")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ok.Close()
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+
+	store := &fakeDeliveries{
+		subscriptions: map[int64]*db.WebhookSubscription{
+			10: {ID: 10, URL: ok.URL, Secret: "ok-secret-0123456789"},
+			20: {ID: 20, URL: failing.URL, Secret: "failing-secret-0123"},
+			30: {ID: 30, URL: ok.URL, Secret: "paused-secret-01234", Paused: true},
+		},
+		pending: []*db.WebhookDelivery{
+			{ID: 1, SubscriptionID: 10, EventType: handlers.CONNECT, Payload: `{"event":{}}`},
+			{ID: 2, SubscriptionID: 20, EventType: handlers.CONNECT, Attempts: 0},
+			{ID: 3, SubscriptionID: 20, EventType: handlers.CONNECT, Attempts: 2},
+			{ID: 4, SubscriptionID: 30, EventType: handlers.CONNECT},
+			{ID: 5, SubscriptionID: 40, EventType: handlers.CONNECT},
+			{ID: 6, SubscriptionID: 10, EventType: handlers.CONNECT, NextAttemptAt: now.Add(time.Minute).Unix()},
+		},
+		attempts: map[int64]db.WebhookAttempt{},
+	}
+
+	d := NewDeliverer(fakeTenants{{ID: "a"}}, store)
+	// the test servers listen on loopback, which the default client refuses to dial
+	d.Client = &http.Client{Timeout: DefaultTimeout}
+	d.MaxAttempts = 3
+	d.BatchSize = 2
+	d.now = func() time.Time { return now }
+
+	reports, err := d.Run(context.Background())
+	assert.NoError(t, err, "Expected no error")
+	assert.Equal(t, []Report{{TenantID: "a", Delivered: 1, Retried: 1, Dead: 1}}, reports, "Expected a report for the tenant")
+	assert.Len(t, signatures, 1, "Expected the paused and future deliveries not to be posted")
+
+	assert.Equal(t, db.WebhookAttempt{Status: handlers.WebhookDelivered, StatusCode: 204, DeliveredAt: now.Unix()},
+		store.attempts[1], "Expected the delivery to succeed")
+	assert.Equal(t, db.WebhookAttempt{
+		Status:        handlers.WebhookPending,
+		StatusCode:    503,
+		NextAttemptAt: now.Add(DefaultBaseBackoff).Unix(),
+		Error:         "unexpected response status " + strconv.Itoa(503) + " Service Unavailable",
+	}, store.attempts[2], "Expected the first failure to be retried after the base backoff")
+	assert.Equal(t, handlers.WebhookDead, store.attempts[3].Status, "Expected the last attempt to kill the delivery")
+	assert.Equal(t, handlers.WebhookCancelled, store.attempts[5].Status, "Expected a deleted subscription to cancel the delivery")
+	assert.NotContains(t, store.attempts, int64(4), "Expected the paused delivery to be left pending")
+	assert.NotContains(t, store.attempts, int64(6), "Expected the future delivery to be left pending")
+}
+
+func TestNewClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/redirect" {
+			http.Redirect(w, r, "/target", http.StatusFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	// ensures that internal addresses are refused after the host is resolved
+	_, err := newClient(time.Second).Get(server.URL)
+	assert.True(t, errors.Is(err, errInternalAddress), "Expected a loopback address to be refused")
+	_, err = newClient(time.Second).Get("http://localhost:" + strconv.Itoa(server.Listener.Addr().(*net.TCPAddr).Port))
+	assert.True(t, errors.Is(err, errInternalAddress), "Expected a name resolving to loopback to be refused")
+
+	// ensures that redirects are returned rather than followed
+	c := newClient(time.Second)
+	c.Transport = http.DefaultTransport
+	resp, err := c.Get(server.URL + "/redirect")
+	if assert.NoError(t, err, "Expected no error") {
+		resp.Body.Close()
+		assert.Equal(t, http.StatusFound, resp.StatusCode, "Expected the redirect itself")
+	}
+
+	assert.False(t, isInternal(net.ParseIP("93.184.216.34")), "Expected a public address to be allowed")
+	assert.True(t, isInternal(net.ParseIP("169.254.169.254")), "Expected the metadata address to be refused")
+	assert.True(t, isInternal(net.ParseIP("fd00::1")), "Expected a unique local address to be refused")
+}
+
+func TestDeliverer_backoff(t *testing.T) {
+	d := NewDeliverer(nil, nil)
+	assert.Equal(t, 30*time.Second, d.backoff(1), "Expected the base backoff first")
+	assert.Equal(t, 2*time.Minute, d.backoff(3), "Expected the backoff to double")
+	assert.Equal(t, time.Hour, d.backoff(20), "Expected the backoff to be capped")
+}
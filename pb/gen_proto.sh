@@ -3,10 +3,15 @@ if [ -f "$(command -v protoc)" ]; then
     VER=$(protoc --version)
     PBDIR="/Users/AdrianAgnic/work/call-handling/pb/"
     echo "Using protoc version: $VER"
+    # google/api/annotations.proto ships with grpc-gateway
+    GAPI="$(go list -m -f '{{.Dir}}' github.com/grpc-ecosystem/grpc-gateway)/third_party/googleapis"
     protoc \
       --proto_path=$PBDIR \
+      --proto_path=$GAPI \
       --go_out=plugins=grpc:$PBDIR \
-      --go_opt=paths=source_relative $PBDIR*.proto
+      --go_opt=paths=source_relative \
+      --grpc-gateway_out=logtostderr=true,paths=source_relative:$PBDIR \
+      --swagger_out=logtostderr=true:$PBDIR $PBDIR*.proto
 else
     echo "Error: protoc was not found. Please check that it is installed."
 fi
\ No newline at end of file
@@ -58,6 +58,20 @@ data "aws_secretsmanager_secret" "rds_db_pass" {
   ]
 }
 
+data "aws_secretsmanager_secret" "pii_index_key" {
+  name       = "${local.service_name}_pii_index_key"
+  depends_on = [
+    aws_secretsmanager_secret.pii_index_key_string
+  ]
+}
+
+data "aws_secretsmanager_secret" "auth_token_key" {
+  name       = "${local.service_name}_auth_token_key"
+  depends_on = [
+    aws_secretsmanager_secret.auth_token_key_string
+  ]
+}
+
 data "aws_secretsmanager_secret" "db_migration_src" {
   name       = "${local.service_name}_db_migration_src"
   depends_on = [
@@ -112,6 +126,20 @@ data template_file "task_definition" {
     db_migrations_src = data.aws_secretsmanager_secret.db_migration_src.arn
     waitfordbhost     = module.rds_db.rds_instance_address
 
+    ####################
+    # PII Encryption
+    ####################
+    pii_key_provider   = "kms"
+    pii_kms_key_id     = aws_kms_key.pii.arn
+    pii_keyring_file   = "none"
+    pii_index_key      = data.aws_secretsmanager_secret.pii_index_key.arn
+    pii_rekey_interval = var.pii_rekey_interval[ terraform.workspace ]
+
+    ####################
+    # Auth
+    ####################
+    auth_token_key = data.aws_secretsmanager_secret.auth_token_key.arn
+
 
     #########################
     # Logging (Application)
@@ -120,7 +148,7 @@ data template_file "task_definition" {
     log_level             = var.log_level[terraform.workspace]
     log_enable_dev        = var.log_enable_dev[terraform.workspace]
     log_stream_monitoring = module.firehose.firehose_monitoring_stream_name
-
+    log_stream_reporting  = var.log_stream_reporting[terraform.workspace]
     log_disable_kinesis   = var.log_disable_kinesis[terraform.workspace]
     log_flush_interval    = var.log_flush_interval[terraform.workspace]
     log_buffer_size       = var.log_buffer_size[terraform.workspace]
@@ -133,6 +161,33 @@ data template_file "task_definition" {
     trace_disable          = var.trace_disable[ terraform.workspace ]
     trace_sample_rate      = var.trace_sample_rate[ terraform.workspace ]
 
+    #################
+    # PII Redaction
+    #################
+    pii_sensitive_fields = var.pii_sensitive_fields[ terraform.workspace ]
+
+    #############
+    # Retention
+    #############
+    retention_interval = var.retention_interval[ terraform.workspace ]
+
+    ###########
+    # OpenAPI
+    ###########
+    openapi_spec_file = "/root/openapi.json"
+
+    ##########
+    # Reaper
+    ##########
+    reaper_interval = var.reaper_interval[ terraform.workspace ]
+
+    reaper_max_call_duration = var.reaper_max_call_duration[ terraform.workspace ]
+
+    ############
+    # Webhooks
+    ############
+    webhook_interval = var.webhook_interval[ terraform.workspace ]
+
     #################
     # sentry
     #################
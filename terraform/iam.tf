@@ -35,7 +35,34 @@ data "aws_iam_policy_document" "ecs_task_role" {
       "arn:aws:secretsmanager:${var.aws_region}:${data.aws_caller_identity.current.account_id}:secret:${local.service_name}_rds_db_pass-??????",
       "arn:aws:secretsmanager:${var.aws_region}:${data.aws_caller_identity.current.account_id}:secret:${local.service_name}_db_migration_src-??????",
 
-      "arn:aws:secretsmanager:${var.aws_region}:${data.aws_caller_identity.current.account_id}:secret:${local.service_name}_sentry_dsn-??????"
+      "arn:aws:secretsmanager:${var.aws_region}:${data.aws_caller_identity.current.account_id}:secret:${local.service_name}_sentry_dsn-??????",
+      "arn:aws:secretsmanager:${var.aws_region}:${data.aws_caller_identity.current.account_id}:secret:${local.service_name}_pii_index_key-??????",
+      "arn:aws:secretsmanager:${var.aws_region}:${data.aws_caller_identity.current.account_id}:secret:${local.service_name}_auth_token_key-??????"
+    ]
+  }
+
+  statement {
+    sid       = "ReportingStreamAccess"
+    effect    = "Allow"
+    actions   = [
+      "firehose:PutRecord",
+      "firehose:PutRecordBatch"
+    ]
+    resources = [
+      "arn:aws:firehose:${var.aws_region}:${data.aws_caller_identity.current.account_id}:deliverystream/${var.log_stream_reporting[terraform.workspace]}"
+    ]
+  }
+
+  statement {
+    sid       = "PIIKeyAccess"
+    effect    = "Allow"
+    actions   = [
+      "kms:Encrypt",
+      "kms:Decrypt",
+      "kms:GenerateDataKey"
+    ]
+    resources = [
+      aws_kms_key.pii.arn
     ]
   }
 }
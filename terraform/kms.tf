@@ -0,0 +1,11 @@
+resource "aws_kms_key" "pii" {
+  description             = "Wraps the data keys that envelope encrypt ${local.service_name} PII."
+  deletion_window_in_days = 30
+  enable_key_rotation     = true
+  tags                    = local.tags
+}
+
+resource "aws_kms_alias" "pii" {
+  name          = "alias/${local.service_name}-pii"
+  target_key_id = aws_kms_key.pii.key_id
+}
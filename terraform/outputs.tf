@@ -2,3 +2,8 @@
 output "service_url" {
   value = module.service_dns_record.fqdn
 }
+
+# the issuer of bearer tokens reads the key it signs them with from this secret
+output "auth_token_key_secret_arn" {
+  value = aws_secretsmanager_secret.auth_token_key_string.arn
+}
@@ -58,3 +58,51 @@ resource "aws_secretsmanager_secret_version" "db_migration_src_version" {
 data "aws_secretsmanager_secret_version" "acm_ssl_cert" {
   secret_id = data.terraform_remote_state.secrets.outputs.acm_ssl_cert_arn
 }
+
+
+resource "random_id" "pii_index_key" {
+  byte_length = 32
+}
+
+resource "aws_secretsmanager_secret" "pii_index_key_string" {
+  name                    = "${local.service_name}_pii_index_key"
+  description             = "The HMAC key of the blind indexes over encrypted phone numbers. It cannot be rotated."
+  recovery_window_in_days = var.secretsmanager_recovery_window[ terraform.workspace ]
+  tags                    = local.tags
+}
+
+resource "aws_secretsmanager_secret_version" "pii_index_key_version" {
+  secret_id      = aws_secretsmanager_secret.pii_index_key_string.id
+  secret_string  = random_id.pii_index_key.b64_std
+  version_stages = [
+    module.workspace_context.workspace_deploy_env[ terraform.workspace ]
+  ]
+  depends_on     = [
+    aws_secretsmanager_secret.pii_index_key_string,
+    random_id.pii_index_key
+  ]
+}
+
+
+resource "random_id" "auth_token_key" {
+  byte_length = 32
+}
+
+resource "aws_secretsmanager_secret" "auth_token_key_string" {
+  name                    = "${local.service_name}_auth_token_key"
+  description             = "The HMAC key of the bearer tokens carrying a caller's role, shared with their issuer."
+  recovery_window_in_days = var.secretsmanager_recovery_window[ terraform.workspace ]
+  tags                    = local.tags
+}
+
+resource "aws_secretsmanager_secret_version" "auth_token_key_version" {
+  secret_id      = aws_secretsmanager_secret.auth_token_key_string.id
+  secret_string  = random_id.auth_token_key.b64_std
+  version_stages = [
+    module.workspace_context.workspace_deploy_env[ terraform.workspace ]
+  ]
+  depends_on     = [
+    aws_secretsmanager_secret.auth_token_key_string,
+    random_id.auth_token_key
+  ]
+}
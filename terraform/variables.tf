@@ -53,6 +53,16 @@ variable "log_enable_dev" {
   }
 }
 
+variable "log_stream_reporting" {
+  description = "The Firehose delivery stream CDRs and job results are reported to"
+  type        = map(string)
+  default     = {
+    caring-dev : "call-handling-reporting",
+    caring-stg : "call-handling-reporting",
+    caring-prod : "call-handling-reporting"
+  }
+}
+
 variable "log_disable_kinesis" {
   description = "If set to TRUE, containerized application will not log via Kinesis"
   type        = map(string)
@@ -133,6 +143,66 @@ variable "sentry_disable" {
   }
 }
 
+variable "pii_rekey_interval" {
+  description = "How often to re-encrypt rows sealed under a retired PII master key"
+  type        = map(string)
+  default     = {
+    caring-dev : "1h",
+    caring-stg : "1h",
+    caring-prod : "1h"
+  }
+}
+
+variable "pii_sensitive_fields" {
+  description = "Comma separated fields masked in logs, traces, Sentry and error messages"
+  type        = map(string)
+  default     = {
+    caring-dev : "ANI,ANI_e164,meta",
+    caring-stg : "ANI,ANI_e164,meta",
+    caring-prod : "ANI,ANI_e164,meta"
+  }
+}
+
+variable "retention_interval" {
+  description = "How often to purge data past each tenant's retention period"
+  type        = map(string)
+  default     = {
+    caring-dev : "24h",
+    caring-stg : "24h",
+    caring-prod : "24h"
+  }
+}
+
+variable "reaper_interval" {
+  description = "How often to disconnect calls whose disconnect event was lost"
+  type        = map(string)
+  default     = {
+    caring-dev : "15m",
+    caring-stg : "15m",
+    caring-prod : "15m"
+  }
+}
+
+variable "reaper_max_call_duration" {
+  description = "How long a call may go without a disconnect before it is reaped, unless its tenant sets its own"
+  type        = map(string)
+  default     = {
+    caring-dev : "4h",
+    caring-stg : "4h",
+    caring-prod : "4h"
+  }
+}
+
+variable "webhook_interval" {
+  description = "How often to post due webhook deliveries"
+  type        = map(string)
+  default     = {
+    caring-dev : "10s",
+    caring-stg : "10s",
+    caring-prod : "10s"
+  }
+}
+
 
 variable "rds_instance_class" {
   description = "The EC2 instance type to use for the RDS instance"